@@ -0,0 +1,94 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package actions
+
+import (
+	"fmt"
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/wxx"
+	"log"
+	"sort"
+)
+
+// TrailLayers_e controls how unit trails are grouped into map layers.
+type TrailLayers_e int
+
+const (
+	NoTrails     TrailLayers_e = iota
+	TrailsByUnit               // one layer for each unit
+	TrailsByType               // one layer for each type of unit, plus one for scouts
+)
+
+// MapTrails returns the path of every unit.
+// The input must have been walked so that the locations of the moves are set.
+//
+// Each unit's trail runs from the hex it started the first turn in through
+// every step it took, ending in the hex it ended the last turn in. Scouting
+// parties get a separate trail for each turn.
+func MapTrails(input []*parser.Turn_t, layers TrailLayers_e) (list []*wxx.Trail) {
+	if layers == NoTrails {
+		return nil
+	}
+
+	layerFor := func(unitId parser.UnitId_t, scout bool) string {
+		if layers == TrailsByUnit {
+			return fmt.Sprintf("Tribenet Trail %s", unitId)
+		} else if scout {
+			return "Tribenet Trails Scouts"
+		}
+		return fmt.Sprintf("Tribenet Trails %s", unitId.Type())
+	}
+
+	trails := map[parser.UnitId_t]*wxx.Trail{}
+	var scouts []*wxx.Trail
+	for _, turn := range input {
+		for _, moves := range turn.SortedMoves {
+			if moves.Location.IsZero() {
+				// the unit wasn't placed on the map; the walk has reported the error
+				continue
+			}
+			trail, ok := trails[moves.Id]
+			if !ok {
+				trail = &wxx.Trail{Layer: layerFor(moves.Id, false), UnitId: string(moves.Id), Type: moves.Id.Type()}
+				trails[moves.Id] = trail
+			}
+			if start, err := coords.HexToMap(moves.StartHex); err == nil {
+				trail.Steps = append(trail.Steps, &wxx.TrailStep{Location: start})
+			}
+			for _, move := range moves.Moves {
+				if !move.Location.IsZero() {
+					trail.Steps = append(trail.Steps, &wxx.TrailStep{Location: move.Location})
+				}
+			}
+			trail.Steps = append(trail.Steps, &wxx.TrailStep{Location: moves.Location, TurnId: turn.Id})
+
+			for _, scout := range moves.Scouts {
+				scoutTrail := &wxx.Trail{Layer: layerFor(moves.Id, true), UnitId: string(moves.Id), Type: moves.Id.Type(), Scout: true}
+				scoutTrail.Steps = append(scoutTrail.Steps, &wxx.TrailStep{Location: moves.Location})
+				for _, move := range scout.Moves {
+					if !move.Location.IsZero() {
+						scoutTrail.Steps = append(scoutTrail.Steps, &wxx.TrailStep{Location: move.Location})
+					}
+				}
+				scouts = append(scouts, scoutTrail)
+			}
+		}
+	}
+
+	// return the trails in unit order so that the layers are sorted
+	var unitIds []parser.UnitId_t
+	for unitId := range trails {
+		unitIds = append(unitIds, unitId)
+	}
+	sort.Slice(unitIds, func(i, j int) bool {
+		return unitIds[i] < unitIds[j]
+	})
+	for _, unitId := range unitIds {
+		list = append(list, trails[unitId])
+	}
+	list = append(list, scouts...)
+
+	log.Printf("map: collected %8d trails and %8d scout trails\n", len(trails), len(scouts))
+	return list
+}
@@ -27,9 +27,11 @@ type MapConfig struct {
 	}
 }
 
-func MapWorld(allTiles *tiles.Map_t, clan parser.UnitId_t, cfg MapConfig) (*wxx.WXX, error) {
+// MapWorld converts the world map into the hexes that the renderers draw.
+// Units in any of the clans are shown as friendly.
+func MapWorld(allTiles *tiles.Map_t, clans []parser.UnitId_t, cfg MapConfig) (*Map_t, error) {
 	if allTiles.Length() == 0 {
-		log.Fatalf("error: no tiles to map\n")
+		return nil, fmt.Errorf("no tiles to map")
 	}
 	log.Printf("map: collected %8d tiles\n", allTiles.Length())
 
@@ -42,11 +44,10 @@ func MapWorld(allTiles *tiles.Map_t, clan parser.UnitId_t, cfg MapConfig) (*wxx.
 		//}
 	}
 
-	consolidatedMap := wxx.NewWXX()
-
 	// create an offset that will shift the map to about 4 hexes from the upper left.
 	var renderOffset coords.Map
 	upperLeft, lowerRight := allTiles.Bounds()
+	consolidatedMap := &Map_t{UpperLeft: upperLeft, LowerRight: lowerRight}
 	log.Printf("map: upper left  grid %s\n", upperLeft.GridString())
 	log.Printf("map: lower right grid %s\n", lowerRight.GridString())
 	if cfg.Render.ShiftMap {
@@ -80,6 +81,7 @@ func MapWorld(allTiles *tiles.Map_t, clan parser.UnitId_t, cfg MapConfig) (*wxx.
 			},
 			WasVisited: t.Visited != "",
 			WasScouted: t.Scouted != "",
+			LastSeen:   max(t.Visited, t.Scouted),
 		}
 
 		// todo: one way fords and one way passes?
@@ -102,12 +104,30 @@ func MapWorld(allTiles *tiles.Map_t, clan parser.UnitId_t, cfg MapConfig) (*wxx.
 		}
 
 		for _, encounter := range t.Encounters {
-			if encounter.UnitId.InClan(clan) {
-				encounter.Friendly = true
+			for _, clan := range clans {
+				if encounter.UnitId.InClan(clan) {
+					encounter.Friendly = true
+				}
 			}
 			hex.Features.Encounters = append(hex.Features.Encounters, encounter)
 		}
 
+		for _, item := range t.Items {
+			hex.Features.Items = append(hex.Features.Items, item)
+		}
+
+		for _, lh := range t.Longhouses {
+			hex.Features.Longhouses = append(hex.Features.Longhouses, lh)
+		}
+
+		for _, obstacle := range t.Obstacles {
+			hex.Features.Obstacles = append(hex.Features.Obstacles, obstacle)
+		}
+
+		for _, patrol := range t.Patrols {
+			hex.Features.Patrols = append(hex.Features.Patrols, patrol)
+		}
+
 		for _, resource := range t.Resources {
 			hex.Features.Resources = append(hex.Features.Resources, resource)
 		}
@@ -116,11 +136,16 @@ func MapWorld(allTiles *tiles.Map_t, clan parser.UnitId_t, cfg MapConfig) (*wxx.
 			hex.Features.Settlements = append(hex.Features.Settlements, settlement)
 		}
 
-		worldHexMap[hex.RenderAt] = hex
+		for _, sighting := range t.Sightings {
+			hex.Features.Sightings = append(hex.Features.Sightings, sighting)
+		}
 
-		if err := consolidatedMap.MergeHex(hex); err != nil {
-			log.Fatalf("error: wxx: mergeHexes: newHexes: %v\n", err)
+		for _, wind := range t.Winds {
+			hex.Features.Winds = append(hex.Features.Winds, wind)
 		}
+
+		worldHexMap[hex.RenderAt] = hex
+		consolidatedMap.Hexes = append(consolidatedMap.Hexes, hex)
 	}
 
 	log.Printf("map: collected %8d new     hexes\n", len(worldHexMap))
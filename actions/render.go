@@ -0,0 +1,113 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/raster"
+	"github.com/mdhender/ottomap/internal/svg"
+	"github.com/mdhender/ottomap/internal/wxx"
+	"log"
+	"os"
+)
+
+// Map_t is the map that the renderers draw. It doesn't depend on the
+// output format, so the walker doesn't need to know about the renderers.
+type Map_t struct {
+	TurnId     string     // last turn on the map
+	UpperLeft  coords.Map // bounds of the world map
+	LowerRight coords.Map
+	Hexes      []*wxx.Hex   // hexes to draw, with the features in each hex
+	Trails     []*wxx.Trail // optional paths of the units
+}
+
+// Renderer creates a map file from the map.
+type Renderer interface {
+	// Extension returns the file extension for the map, for example ".wxx".
+	Extension() string
+	// Render writes the map to the file.
+	Render(path string, m *Map_t) error
+}
+
+// Formats are the output formats that NewRenderer accepts.
+// The first one is the default.
+var Formats = []string{"wxx", "svg", "png"}
+
+// NewRenderer returns the renderer for the output format.
+// An empty format returns the default renderer.
+func NewRenderer(format string, cfg wxx.RenderConfig) (Renderer, error) {
+	switch format {
+	case "", "wxx":
+		return &Worldographer{Config: cfg}, nil
+	case "svg":
+		return &SVG{Config: cfg}, nil
+	case "png":
+		return &PNG{Config: cfg}, nil
+	}
+	return nil, fmt.Errorf("format %q: not supported", format)
+}
+
+// Worldographer renders the map as a Worldographer (.wxx) file.
+type Worldographer struct {
+	Config wxx.RenderConfig
+}
+
+// Extension implements the Renderer interface.
+func (r *Worldographer) Extension() string {
+	return ".wxx"
+}
+
+// Render implements the Renderer interface.
+func (r *Worldographer) Render(path string, m *Map_t) error {
+	w := wxx.NewWXX()
+	if r.Config.KeepAnnotations {
+		if doc, err := wxx.Read(path); err == nil {
+			w.KeepAnnotations(doc)
+		} else if errors.Is(err, os.ErrNotExist) {
+			log.Printf("wxx: %s: no existing map to keep annotations from\n", path)
+		} else {
+			return fmt.Errorf("keep annotations: %w", err)
+		}
+	}
+	for _, hex := range m.Hexes {
+		if err := w.MergeHex(hex); err != nil {
+			return fmt.Errorf("wxx: mergeHexes: %w", err)
+		}
+	}
+	for _, trail := range m.Trails {
+		w.AddTrail(trail)
+	}
+	return w.Create(path, m.TurnId, m.UpperLeft, m.LowerRight, r.Config)
+}
+
+// SVG renders the map as a standalone SVG file.
+type SVG struct {
+	Config wxx.RenderConfig
+}
+
+// Extension implements the Renderer interface.
+func (r *SVG) Extension() string {
+	return ".svg"
+}
+
+// Render implements the Renderer interface.
+func (r *SVG) Render(path string, m *Map_t) error {
+	return svg.Create(path, m.TurnId, m.Hexes, m.Trails, r.Config)
+}
+
+// PNG renders the map as a PNG image.
+type PNG struct {
+	Config wxx.RenderConfig
+}
+
+// Extension implements the Renderer interface.
+func (r *PNG) Extension() string {
+	return ".png"
+}
+
+// Render implements the Renderer interface.
+func (r *PNG) Render(path string, m *Map_t) error {
+	return raster.Create(path, m.Hexes, m.Trails, r.Config)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package main
+
+import (
+	"fmt"
+	"github.com/mdhender/ottomap/internal/diagnostics"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/turns"
+	"github.com/spf13/cobra"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var argsCalendar struct {
+	paths struct {
+		data  string
+		input string
+	}
+}
+
+var cmdCalendar = &cobra.Command{
+	Use:   "calendar",
+	Short: "List the turns in the turn reports",
+	Long:  `List the season, weather, and next turn for each turn report and flag any missing turns.`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if argsCalendar.paths.data == "" {
+			return fmt.Errorf("path to data folder is required")
+		} else if strings.TrimSpace(argsCalendar.paths.data) != argsCalendar.paths.data {
+			log.Fatalf("error: data: leading or trailing spaces are not allowed\n")
+		} else if path, err := abspath(argsCalendar.paths.data); err != nil {
+			log.Fatalf("error: data: %v\n", err)
+		} else {
+			argsCalendar.paths.data = path
+		}
+
+		argsCalendar.paths.input = filepath.Join(argsCalendar.paths.data, "input")
+		if path, err := abspath(argsCalendar.paths.input); err != nil {
+			log.Fatalf("error: data: %v\n", err)
+		} else {
+			argsCalendar.paths.input = path
+		}
+
+		return nil
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		inputs, err := turns.CollectInputs(argsCalendar.paths.input, 9999, 12)
+		if err != nil {
+			log.Fatalf("error: inputs: %v\n", err)
+		}
+
+		// parse the reports and keep the first report for each turn.
+		// the other reports for a turn only add to the list of report ids.
+		dx := diagnostics.New()
+		allTurns := map[string]*parser.Turn_t{}
+		reportIds := map[string][]string{}
+		for _, i := range inputs {
+			data, err := os.ReadFile(i.Path)
+			if err != nil {
+				log.Fatalf("error: read: %v\n", err)
+			}
+			turn, err := parser.ParseInput(i.Id, i.Turn.Id, data, false, false, false, false, false, parser.ParseConfig{}, dx)
+			if err != nil {
+				log.Printf("%q: %v\n", i.Id, err)
+			}
+			if turn == nil || turn.Id == "" {
+				continue
+			} else if turn.Id != i.Turn.Id {
+				log.Printf("%q: expected turn %q: got turn %q\n", i.Id, i.Turn.Id, turn.Id)
+				continue
+			}
+			if allTurns[turn.Id] == nil {
+				allTurns[turn.Id] = turn
+			}
+			reportIds[turn.Id] = append(reportIds[turn.Id], i.Id)
+		}
+		var sortedTurns []*parser.Turn_t
+		for _, turn := range allTurns {
+			sortedTurns = append(sortedTurns, turn)
+		}
+		sort.Slice(sortedTurns, func(i, j int) bool {
+			return sortedTurns[i].Id < sortedTurns[j].Id
+		})
+
+		// the diagnostics from the parser aren't interesting here, so only show the gaps
+		gaps := diagnostics.New()
+		missing := turns.CheckCalendar(sortedTurns, gaps)
+
+		fmt.Printf("%-7s  %-8s  %-12s  %-7s  %-10s  %s\n", "Turn", "Season", "Weather", "Next", "Date", "Reports")
+		for n, turn := range sortedTurns {
+			if n > 0 {
+				for _, id := range missing {
+					if sortedTurns[n-1].Id < id && id < turn.Id {
+						fmt.Printf("%-7s  %s\n", id, "** missing **")
+					}
+				}
+			}
+			fmt.Printf("%-7s  %-8s  %-12s  %-7s  %-10s  %s\n", turn.Id, turn.Season, turn.Weather, turn.NextTurnId, turn.ReportDate, strings.Join(reportIds[turn.Id], " "))
+		}
+		if len(missing) != 0 {
+			gaps.Log()
+		}
+		if n := dx.Errors(); n != 0 {
+			log.Printf("calendar: %d errors parsing the reports; run render for details\n", n)
+		}
+	},
+}
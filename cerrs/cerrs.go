@@ -41,8 +41,10 @@ const (
 	ErrParseFailed                = Error("parse failed")
 	ErrPragmaReturnedNil          = Error("pragma returned nil")
 	ErrSetupExists                = Error("setup.json exists")
+	ErrTerrainConflict            = Error("terrain conflict")
 	ErrTooManyScoutLines          = Error("too many scout lines")
 	ErrTrackingGarrison           = Error("tracking garrison")
+	ErrTurnsOutOfOrder            = Error("turns out of order")
 	ErrUnableToFindStartingHex    = Error("unable to find starting hex")
 	ErrUnexpectedNumberOfMoves    = Error("unexpected number of moves")
 	ErrUnitMovesAndFollows        = Error("unit moves and follows")
@@ -0,0 +1,132 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/mdhender/ottomap/internal/tiles"
+	"github.com/mdhender/ottomap/internal/turns"
+	"github.com/mdhender/ottomap/pipeline"
+	"github.com/spf13/cobra"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var argsDiff struct {
+	paths struct {
+		data   string
+		input  string
+		output string
+	}
+	clanId     string
+	allies     []string // other clans in the alliance
+	from       string   // last turn of the earlier map
+	to         string   // last turn of the later map
+	originGrid string
+	wxx        bool // write a map of the changes
+}
+
+var cmdDiff = &cobra.Command{
+	Use:   "diff",
+	Short: "List what the turns after a turn revealed",
+	Long:  `Walk the turn reports through two turns and list the hexes, terrain, settlements, resources, encounters, and edges that are new in the later map.`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if len(argsDiff.clanId) != 4 || argsDiff.clanId[0] != '0' {
+			return fmt.Errorf("clan-id must be a 4 digit number starting with 0")
+		} else if n, err := strconv.Atoi(argsDiff.clanId[1:]); err != nil || n < 0 || n > 9999 {
+			return fmt.Errorf("clan-id must be a 4 digit number starting with 0")
+		}
+		for _, ally := range argsDiff.allies {
+			if len(ally) != 4 || ally[0] != '0' {
+				return fmt.Errorf("allies: %q: must be a 4 digit number starting with 0", ally)
+			} else if n, err := strconv.Atoi(ally[1:]); err != nil || n < 0 || n > 9999 {
+				return fmt.Errorf("allies: %q: must be a 4 digit number starting with 0", ally)
+			}
+		}
+
+		if _, _, err := turns.ParseTurnId(argsDiff.from); err != nil {
+			return fmt.Errorf("from: %q: %v", argsDiff.from, err)
+		} else if _, _, err := turns.ParseTurnId(argsDiff.to); argsDiff.to != "" && err != nil {
+			return fmt.Errorf("to: %q: %v", argsDiff.to, err)
+		} else if argsDiff.to != "" && argsDiff.to <= argsDiff.from {
+			return fmt.Errorf("to: %q: must be after %q", argsDiff.to, argsDiff.from)
+		}
+
+		if argsDiff.paths.data == "" {
+			return fmt.Errorf("path to data folder is required")
+		} else if strings.TrimSpace(argsDiff.paths.data) != argsDiff.paths.data {
+			log.Fatalf("error: data: leading or trailing spaces are not allowed\n")
+		} else if path, err := abspath(argsDiff.paths.data); err != nil {
+			log.Fatalf("error: data: %v\n", err)
+		} else {
+			argsDiff.paths.data = path
+		}
+
+		argsDiff.paths.input = filepath.Join(argsDiff.paths.data, "input")
+		if path, err := abspath(argsDiff.paths.input); err != nil {
+			log.Fatalf("error: data: %v\n", err)
+		} else {
+			argsDiff.paths.input = path
+		}
+
+		// the output folder is only needed for the map of the changes
+		argsDiff.paths.output = filepath.Join(argsDiff.paths.data, "output")
+		if argsDiff.wxx {
+			if path, err := abspath(argsDiff.paths.output); err != nil {
+				log.Fatalf("error: data: %v\n", err)
+			} else if sb, err := os.Stat(path); err != nil {
+				log.Fatalf("error: data: %v\n", err)
+			} else if !sb.IsDir() {
+				log.Fatalf("error: data: %v is not a directory\n", path)
+			} else {
+				argsDiff.paths.output = path
+			}
+		}
+
+		if argsDiff.originGrid != "" {
+			if len(argsDiff.originGrid) != 2 || strings.Trim(argsDiff.originGrid, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+				log.Fatalf("error: originGrid %q: must be two upper-case letters\n", argsDiff.originGrid)
+			}
+		}
+
+		return nil
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		opts := pipeline.Options{
+			ClanId:            argsDiff.clanId,
+			Allies:            argsDiff.allies,
+			InputPath:         argsDiff.paths.input,
+			OutputPath:        argsDiff.paths.output,
+			MaxTurnId:         argsDiff.to,
+			OriginGrid:        argsDiff.originGrid,
+			QuitOnInvalidGrid: argsDiff.originGrid == "",
+			WarnOnInvalidGrid: true,
+		}
+		if argsDiff.wxx {
+			opts.Format = "wxx"
+		}
+		d, result, err := pipeline.Diff(opts, argsDiff.from)
+		if errors.Is(err, pipeline.ErrDiagnostics) {
+			exitWithDiagnostics(result.Diagnostics)
+		} else if err != nil {
+			log.Fatalf("error: %v\n", err)
+		}
+
+		fmt.Printf("changes from %s to %s\n", d.From, d.To)
+		if len(d.Changes) == 0 {
+			fmt.Printf("    none\n")
+		}
+		// group the changes by kind so that the briefing reads well
+		for _, kind := range []tiles.Change_e{tiles.NewHex, tiles.NewTerrain, tiles.NewSettlement, tiles.NewResource, tiles.NewEncounter, tiles.NewEdge} {
+			for _, change := range d.Changes {
+				if change.Kind == kind {
+					fmt.Printf("%-10s  %s  %s\n", change.Kind, change.Location.GridString(), change.Text)
+				}
+			}
+		}
+	},
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+// Package atlas implements a JSON file that persists the world map between render runs.
+//
+// The atlas holds the tiles created by walking the turn reports and the last
+// location of every unit that was seen. Loading it lets the walker pick up at
+// the first turn after the atlas instead of starting over from the first report.
+package atlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/direction"
+	"github.com/mdhender/ottomap/internal/edges"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/resources"
+	"github.com/mdhender/ottomap/internal/terrain"
+	"github.com/mdhender/ottomap/internal/tiles"
+	"os"
+	"sort"
+)
+
+// Version must be updated if the layout of the atlas changes.
+// Atlas files with a different version are rejected.
+const Version = 6
+
+// Atlas_t is the serialized version of the world map.
+type Atlas_t struct {
+	Version int       `json:"version"`
+	ClanId  string    `json:"clanId"`
+	TurnId  string    `json:"turnId"` // last turn walked into the atlas
+	Tiles   []*Tile_t `json:"tiles,omitempty"`
+	Units   []*Unit_t `json:"units,omitempty"` // last seen location of each unit
+}
+
+// Tile_t is the serialized version of a tile.
+// Locations are stored as grid coordinates ("AB 0101").
+type Tile_t struct {
+	Hex         string                 `json:"hex"`
+	Visited     string                 `json:"visited,omitempty"`
+	Scouted     string                 `json:"scouted,omitempty"`
+	Terrain     terrain.Terrain_e      `json:"terrain"`
+	Edges       []*Edge_t              `json:"edges,omitempty"`
+	Encounters  []*parser.Encounter_t  `json:"encounters,omitempty"`
+	Items       []*parser.FoundItem_t  `json:"items,omitempty"`
+	Longhouses  []*parser.Longhouse_t  `json:"longhouses,omitempty"`
+	Obstacles   []*parser.Obstacle_t   `json:"obstacles,omitempty"`
+	Patrols     []*parser.Patrol_t     `json:"patrols,omitempty"`
+	Resources   []resources.Resource_e `json:"resources,omitempty"`
+	Settlements []*parser.Settlement_t `json:"settlements,omitempty"`
+	Sightings   []*parser.Sighting_t   `json:"sightings,omitempty"`
+	Winds       []*parser.Wind_t       `json:"winds,omitempty"`
+}
+
+// Edge_t holds the edge features on one side of a tile.
+type Edge_t struct {
+	Direction direction.Direction_e `json:"direction"`
+	Edges     []edges.Edge_e        `json:"edges"`
+}
+
+// Unit_t is the last location a unit was seen in.
+type Unit_t struct {
+	Id  parser.UnitId_t `json:"id"`
+	Hex string          `json:"hex"`
+}
+
+// FromMap creates an atlas from the world map and the last seen unit locations.
+func FromMap(clanId, turnId string, worldMap *tiles.Map_t, lastSeen map[parser.UnitId_t]coords.Map) *Atlas_t {
+	a := &Atlas_t{
+		Version: Version,
+		ClanId:  clanId,
+		TurnId:  turnId,
+	}
+
+	for _, tile := range worldMap.Tiles {
+		t := &Tile_t{
+			Hex:         tile.Location.GridString(),
+			Visited:     tile.Visited,
+			Scouted:     tile.Scouted,
+			Terrain:     tile.Terrain,
+			Encounters:  tile.Encounters,
+			Items:       tile.Items,
+			Longhouses:  tile.Longhouses,
+			Obstacles:   tile.Obstacles,
+			Patrols:     tile.Patrols,
+			Resources:   tile.Resources,
+			Settlements: tile.Settlements,
+			Sightings:   tile.Sightings,
+			Winds:       tile.Winds,
+		}
+		for _, d := range direction.Directions {
+			if len(tile.Edges[d]) != 0 {
+				t.Edges = append(t.Edges, &Edge_t{Direction: d, Edges: tile.Edges[d]})
+			}
+		}
+		a.Tiles = append(a.Tiles, t)
+	}
+	sort.Slice(a.Tiles, func(i, j int) bool {
+		return a.Tiles[i].Hex < a.Tiles[j].Hex
+	})
+
+	for id, location := range lastSeen {
+		a.Units = append(a.Units, &Unit_t{Id: id, Hex: location.GridString()})
+	}
+	sort.Slice(a.Units, func(i, j int) bool {
+		return a.Units[i].Id < a.Units[j].Id
+	})
+
+	return a
+}
+
+// ToMap returns the world map and the last seen unit locations from the atlas.
+func (a *Atlas_t) ToMap() (*tiles.Map_t, map[parser.UnitId_t]coords.Map, error) {
+	worldMap := tiles.NewMap()
+	for _, t := range a.Tiles {
+		location, err := coords.HexToMap(t.Hex)
+		if err != nil {
+			return nil, nil, fmt.Errorf("tile %q: %w", t.Hex, err)
+		}
+		tile := worldMap.FetchTile(location)
+		tile.Visited = t.Visited
+		tile.Scouted = t.Scouted
+		tile.Terrain = t.Terrain
+		for _, e := range t.Edges {
+			if e.Direction < direction.North || e.Direction > direction.NorthWest {
+				return nil, nil, fmt.Errorf("tile %q: invalid edge direction %d", t.Hex, e.Direction)
+			}
+			for _, edge := range e.Edges {
+				tile.MergeEdge(e.Direction, edge)
+			}
+		}
+		tile.Encounters = t.Encounters
+		tile.Items = t.Items
+		tile.Longhouses = t.Longhouses
+		tile.Obstacles = t.Obstacles
+		tile.Patrols = t.Patrols
+		tile.Resources = t.Resources
+		tile.Settlements = t.Settlements
+		tile.Sightings = t.Sightings
+		tile.Winds = t.Winds
+	}
+
+	lastSeen := map[parser.UnitId_t]coords.Map{}
+	for _, u := range a.Units {
+		location, err := coords.HexToMap(u.Hex)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unit %q: hex %q: %w", u.Id, u.Hex, err)
+		}
+		lastSeen[u.Id] = location
+	}
+
+	return worldMap, lastSeen, nil
+}
+
+// Load reads an atlas from a JSON file.
+func Load(path string) (*Atlas_t, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var a Atlas_t
+	if err := json.Unmarshal(data, &a); err != nil {
+		return nil, err
+	} else if a.Version != Version {
+		return nil, fmt.Errorf("atlas version %d: expected version %d", a.Version, Version)
+	}
+	return &a, nil
+}
+
+// Save writes the atlas to a JSON file.
+func (a *Atlas_t) Save(path string) error {
+	data, err := json.MarshalIndent(a, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
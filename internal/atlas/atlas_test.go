@@ -0,0 +1,103 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package atlas_test
+
+import (
+	"github.com/mdhender/ottomap/internal/atlas"
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/direction"
+	"github.com/mdhender/ottomap/internal/edges"
+	"github.com/mdhender/ottomap/internal/items"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/resources"
+	"github.com/mdhender/ottomap/internal/results"
+	"github.com/mdhender/ottomap/internal/terrain"
+	"github.com/mdhender/ottomap/internal/tiles"
+	"github.com/mdhender/ottomap/internal/units"
+	"github.com/mdhender/ottomap/internal/winds"
+	"path/filepath"
+	"testing"
+)
+
+func TestAtlasRoundTrip(t *testing.T) {
+	location, err := coords.HexToMap("MH 0714")
+	if err != nil {
+		t.Fatalf("hex: %v", err)
+	}
+	worldMap := tiles.NewMap()
+	tile := worldMap.FetchTile(location)
+	tile.Visited, tile.Scouted = "0900-01", "0899-12"
+	tile.Terrain = terrain.Prairie
+	tile.MergeEdge(direction.NorthEast, edges.River)
+	tile.MergeEdge(direction.NorthEast, edges.Ford)
+	tile.MergeEncounter(&parser.Encounter_t{TurnId: "0900-01", UnitId: "0987", Friendly: false})
+	tile.MergeItem(&parser.FoundItem_t{TurnId: "0900-01", Quantity: 25, Item: items.Horses})
+	tile.MergeLonghouse(&parser.Longhouse_t{TurnId: "0900-01", Id: "L1", Capacity: 1000})
+	tile.MergeObstacle(&parser.Obstacle_t{TurnId: "0900-01", UnitType: units.Clan, Direction: direction.South, Result: results.Blocked, Edge: edges.River})
+	tile.MergeResource(resources.Salt)
+	tile.MergeSettlement(&parser.Settlement_t{TurnId: "0899-12", Name: "Ourtown"})
+	tile.MergeSighting(&parser.Sighting_t{TurnId: "0900-01", UnitId: "0991f1", Terrain: terrain.UnknownLand})
+	tile.MergeWind(&parser.Wind_t{TurnId: "0900-01", UnitId: "0991f1", Strength: winds.Mild, From: direction.NorthEast})
+	lastSeen := map[parser.UnitId_t]coords.Map{"0991": location, "1991e1": location.Add(direction.South)}
+
+	path := filepath.Join(t.TempDir(), "0991.atlas.json")
+	if err := atlas.FromMap("0991", "0900-01", worldMap, lastSeen).Save(path); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	a, err := atlas.Load(path)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if a.ClanId != "0991" || a.TurnId != "0900-01" {
+		t.Errorf("atlas: want 0991/0900-01, got %s/%s", a.ClanId, a.TurnId)
+	}
+	gotMap, gotLastSeen, err := a.ToMap()
+	if err != nil {
+		t.Fatalf("to map: %v", err)
+	}
+	if gotMap.Length() != 1 {
+		t.Fatalf("tiles: want 1, got %d", gotMap.Length())
+	}
+	got := gotMap.Tiles[location]
+	if got == nil {
+		t.Fatalf("tile %s: missing", location.GridString())
+	}
+	if got.Visited != tile.Visited || got.Scouted != tile.Scouted {
+		t.Errorf("visited/scouted: want %s/%s, got %s/%s", tile.Visited, tile.Scouted, got.Visited, got.Scouted)
+	}
+	if got.Terrain != tile.Terrain {
+		t.Errorf("terrain: want %s, got %s", tile.Terrain, got.Terrain)
+	}
+	if len(got.Edges[direction.NorthEast]) != 2 || got.Edges[direction.NorthEast][0] != edges.River || got.Edges[direction.NorthEast][1] != edges.Ford {
+		t.Errorf("edges: want [River Ford], got %v", got.Edges[direction.NorthEast])
+	}
+	if len(got.Encounters) != 1 || got.Encounters[0].UnitId != "0987" {
+		t.Errorf("encounters: want [0987], got %v", got.Encounters)
+	}
+	if len(got.Items) != 1 || got.Items[0].Item != items.Horses || got.Items[0].Quantity != 25 {
+		t.Errorf("items: want [25 Horses], got %v", got.Items)
+	}
+	if len(got.Longhouses) != 1 || got.Longhouses[0].Id != "L1" || got.Longhouses[0].Capacity != 1000 {
+		t.Errorf("longhouses: want [L1 1000], got %v", got.Longhouses)
+	}
+	if len(got.Obstacles) != 1 || got.Obstacles[0].UnitType != units.Clan || got.Obstacles[0].Result != results.Blocked {
+		t.Errorf("obstacles: want [Clan Blocked], got %v", got.Obstacles)
+	}
+	if len(got.Resources) != 1 || got.Resources[0] != resources.Salt {
+		t.Errorf("resources: want [Salt], got %v", got.Resources)
+	}
+	if len(got.Settlements) != 1 || got.Settlements[0].Name != "Ourtown" {
+		t.Errorf("settlements: want [Ourtown], got %v", got.Settlements)
+	}
+	if len(got.Sightings) != 1 || got.Sightings[0].UnitId != "0991f1" || got.Sightings[0].Terrain != terrain.UnknownLand {
+		t.Errorf("sightings: want [0991f1 UnknownLand], got %v", got.Sightings)
+	}
+	if len(got.Winds) != 1 || got.Winds[0].Strength != winds.Mild || got.Winds[0].From != direction.NorthEast {
+		t.Errorf("winds: want [MILD NE], got %v", got.Winds)
+	}
+	for id, want := range lastSeen {
+		if gotLastSeen[id] != want {
+			t.Errorf("last seen %s: want %s, got %s", id, want.GridString(), gotLastSeen[id].GridString())
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+// Package cache implements a cache of parsed turn reports.
+//
+// Entries are keyed by the path to the report file and hold a hash of the
+// file contents. A report is only parsed again if its contents changed,
+// the parser options changed, or the parser itself changed (see Version).
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/mdhender/ottomap/internal/diagnostics"
+	"github.com/mdhender/ottomap/internal/parser"
+	"log"
+	"os"
+)
+
+// Version must be updated whenever the parser returns different results
+// for the same input. Cache files with a different version are ignored.
+const Version = 12
+
+// Cache_t holds the parse results for the turn reports.
+type Cache_t struct {
+	Version int                 `json:"version"`
+	Entries map[string]*Entry_t `json:"entries"` // key is the path to the report file
+}
+
+// Entry_t is the parse result for a single turn report.
+type Entry_t struct {
+	Hash    string         `json:"hash"`    // hash of the report contents
+	Options string         `json:"options"` // parser options used for the parse
+	Turn    *parser.Turn_t `json:"turn"`
+	// Diagnostics are the warnings from the parse. They are replayed on
+	// a cache hit so that they are reported on every run.
+	Diagnostics []*diagnostics.Diagnostic_t `json:"diagnostics,omitempty"`
+}
+
+// New returns an empty cache.
+func New() *Cache_t {
+	return &Cache_t{
+		Version: Version,
+		Entries: map[string]*Entry_t{},
+	}
+}
+
+// Load reads a cache from a JSON file.
+// If the file doesn't exist, can't be read, or was created by a different
+// version of the parser, an empty cache is returned.
+func Load(path string) *Cache_t {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("cache: %s: %v\n", path, err)
+		}
+		return New()
+	}
+	var c Cache_t
+	if err := json.Unmarshal(data, &c); err != nil {
+		log.Printf("cache: %s: %v\n", path, err)
+		return New()
+	} else if c.Version != Version {
+		log.Printf("cache: %s: version %d: expected %d: ignoring\n", path, c.Version, Version)
+		return New()
+	} else if c.Entries == nil {
+		c.Entries = map[string]*Entry_t{}
+	}
+	return &c
+}
+
+// Save writes the cache to a JSON file.
+func (c *Cache_t) Save(path string) error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// Lookup returns the cached parse result for the report, along with the
+// diagnostics from the parse. It returns false if there is no entry or
+// the entry is stale.
+func (c *Cache_t) Lookup(path, hash, options string) (*parser.Turn_t, []*diagnostics.Diagnostic_t, bool) {
+	e, ok := c.Entries[path]
+	if !ok || e.Hash != hash || e.Options != options || e.Turn == nil {
+		return nil, nil, false
+	}
+	return e.Turn, e.Diagnostics, true
+}
+
+// Store adds or replaces the parse result and diagnostics for the report.
+// It must be called before the turn is updated by the walker.
+func (c *Cache_t) Store(path, hash, options string, turn *parser.Turn_t, dx []*diagnostics.Diagnostic_t) {
+	c.Entries[path] = &Entry_t{Hash: hash, Options: options, Turn: turn, Diagnostics: dx}
+}
+
+// Prune removes entries for reports that no longer exist.
+func (c *Cache_t) Prune() {
+	for path := range c.Entries {
+		if _, err := os.Stat(path); err != nil {
+			delete(c.Entries, path)
+		}
+	}
+}
+
+// Hash returns the hash of the report contents.
+func Hash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
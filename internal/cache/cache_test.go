@@ -0,0 +1,51 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package cache_test
+
+import (
+	"github.com/mdhender/ottomap/internal/cache"
+	"github.com/mdhender/ottomap/internal/diagnostics"
+	"github.com/mdhender/ottomap/internal/parser"
+	"path/filepath"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "parse.cache.json")
+	report, options := "0900-01.0991.report.txt", "split-units=false"
+	hash := cache.Hash([]byte("Tribe 0991, , Current Hex = MH 0714"))
+	warning := &diagnostics.Diagnostic_t{Severity: diagnostics.Warning, ReportId: "0900-01.0991", Line: 20, Message: "invalid humans line"}
+
+	c := cache.New()
+	c.Store(report, hash, options, &parser.Turn_t{Id: "0900-01", Year: 900, Month: 1}, []*diagnostics.Diagnostic_t{warning})
+	if err := c.Save(path); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	c = cache.Load(path)
+
+	// a hit returns the turn and replays the warnings from the parse
+	turn, dx, ok := c.Lookup(report, hash, options)
+	if !ok {
+		t.Fatalf("hit: want true, got false")
+	} else if turn.Id != "0900-01" {
+		t.Errorf("hit: turn: want %q, got %q", "0900-01", turn.Id)
+	}
+	if len(dx) != 1 {
+		t.Fatalf("hit: diagnostics: want 1, got %d", len(dx))
+	} else if dx[0].Severity != diagnostics.Warning || dx[0].Line != 20 || dx[0].Message != warning.Message {
+		t.Errorf("hit: diagnostics: want %v, got %v", warning, dx[0])
+	}
+
+	// the file or the options changed, so the report must be parsed again
+	for _, tc := range []struct {
+		name, report, hash, options string
+	}{
+		{"changed file", report, cache.Hash([]byte("Tribe 0991, , Current Hex = MH 0715")), options},
+		{"changed options", report, hash, "split-units=true"},
+		{"new file", "0900-02.0991.report.txt", hash, options},
+	} {
+		if _, _, ok := c.Lookup(tc.report, tc.hash, tc.options); ok {
+			t.Errorf("%s: want miss, got hit", tc.name)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+// Package diagnostics implements a collector for the problems found while
+// parsing and walking the turn reports.
+//
+// The parser and walker append to the collector instead of stopping on the
+// first problem. That lets the render command report every problem in every
+// report in a single run.
+package diagnostics
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Severity_e is an enum for the severity of a diagnostic.
+type Severity_e int
+
+const (
+	Info Severity_e = iota
+	Warning
+	Error
+)
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e Severity_e) MarshalJSON() ([]byte, error) {
+	return json.Marshal(EnumToString[e])
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (e *Severity_e) UnmarshalJSON(data []byte) error {
+	var s string
+	var ok bool
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	} else if *e, ok = StringToEnum[s]; !ok {
+		return fmt.Errorf("invalid Severity %q", s)
+	}
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (e Severity_e) String() string {
+	if str, ok := EnumToString[e]; ok {
+		return str
+	}
+	return fmt.Sprintf("Severity(%d)", int(e))
+}
+
+var (
+	// EnumToString is a helper map for marshalling the enum
+	EnumToString = map[Severity_e]string{
+		Info:    "info",
+		Warning: "warning",
+		Error:   "error",
+	}
+	// StringToEnum is a helper map for unmarshalling the enum
+	StringToEnum = map[string]Severity_e{
+		"info":    Info,
+		"warning": Warning,
+		"error":   Error,
+	}
+)
+
+// Diagnostic_t is a single problem found in a turn report.
+// Line and Column are indexed from 1. They are zero when they don't apply.
+type Diagnostic_t struct {
+	Severity Severity_e `json:"severity"`
+	ReportId string     `json:"reportId,omitempty"` // id of the report file, for example "0900-01.0991"
+	File     string     `json:"file,omitempty"`     // name of the report file
+	TurnId   string     `json:"turnId,omitempty"`
+	UnitId   string     `json:"unitId,omitempty"`
+	Line     int        `json:"line,omitempty"`
+	Column   int        `json:"column,omitempty"`
+	Message  string     `json:"message"`
+	Expected []string   `json:"expected,omitempty"` // tokens the parser expected at the column
+	Text     string     `json:"text,omitempty"`     // the text that caused the problem
+	Fix      string     `json:"fix,omitempty"`      // suggested fix
+}
+
+func (d *Diagnostic_t) String() string {
+	var sb strings.Builder
+	sb.WriteString(d.Severity.String())
+	if d.ReportId != "" {
+		sb.WriteString(": " + d.ReportId)
+	} else if d.TurnId != "" {
+		sb.WriteString(": " + d.TurnId)
+	}
+	if d.UnitId != "" {
+		sb.WriteString(": " + d.UnitId)
+	}
+	if d.Line != 0 {
+		sb.WriteString(fmt.Sprintf(": line %d", d.Line))
+		if d.Column != 0 {
+			sb.WriteString(fmt.Sprintf(" col %d", d.Column))
+		}
+	}
+	sb.WriteString(": " + d.Message)
+	return sb.String()
+}
+
+// Collector_t collects the diagnostics from a run.
+// A nil collector is valid and simply logs the diagnostics added to it.
+type Collector_t struct {
+	Diagnostics []*Diagnostic_t
+}
+
+// New returns an empty collector.
+func New() *Collector_t {
+	return &Collector_t{}
+}
+
+// Add appends a diagnostic to the collector.
+func (c *Collector_t) Add(d *Diagnostic_t) {
+	if c == nil {
+		log.Printf("%s\n", d)
+		return
+	}
+	c.Diagnostics = append(c.Diagnostics, d)
+}
+
+// Errors returns the number of diagnostics with a severity of Error.
+func (c *Collector_t) Errors() int {
+	return c.count(Error)
+}
+
+// Warnings returns the number of diagnostics with a severity of Warning.
+func (c *Collector_t) Warnings() int {
+	return c.count(Warning)
+}
+
+func (c *Collector_t) count(severity Severity_e) int {
+	if c == nil {
+		return 0
+	}
+	n := 0
+	for _, d := range c.Diagnostics {
+		if d.Severity == severity {
+			n++
+		}
+	}
+	return n
+}
+
+// Sort orders the diagnostics by report, line, and column.
+// Diagnostics without a report sort by turn and unit.
+func (c *Collector_t) Sort() {
+	if c == nil {
+		return
+	}
+	sort.SliceStable(c.Diagnostics, func(i, j int) bool {
+		a, b := c.Diagnostics[i], c.Diagnostics[j]
+		if a.TurnId != b.TurnId {
+			return a.TurnId < b.TurnId
+		} else if a.ReportId != b.ReportId {
+			return a.ReportId < b.ReportId
+		} else if a.Line != b.Line {
+			return a.Line < b.Line
+		} else if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+		return a.UnitId < b.UnitId
+	})
+}
+
+// Log sorts and logs all the diagnostics along with the offending text and suggested fix.
+func (c *Collector_t) Log() {
+	if c == nil {
+		return
+	}
+	c.Sort()
+	for _, d := range c.Diagnostics {
+		log.Printf("%s\n", d)
+		if d.Text != "" {
+			log.Printf("    text: %q\n", d.Text)
+		}
+		if d.Fix != "" {
+			log.Printf("    fix:  %s\n", d.Fix)
+		}
+	}
+}
+
+// Report_t is the layout of the file written by Save.
+type Report_t struct {
+	Errors      int             `json:"errors"`
+	Warnings    int             `json:"warnings"`
+	Diagnostics []*Diagnostic_t `json:"diagnostics"`
+}
+
+// Save sorts and writes all the diagnostics to a JSON file.
+// The file is written even if there are no diagnostics so that
+// readers can tell that the run was clean.
+func (c *Collector_t) Save(path string) error {
+	r := Report_t{Diagnostics: []*Diagnostic_t{}}
+	if c != nil {
+		c.Sort()
+		r.Errors, r.Warnings = c.Errors(), c.Warnings()
+		r.Diagnostics = append(r.Diagnostics, c.Diagnostics...)
+	}
+	data, err := json.MarshalIndent(r, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package diagnostics_test
+
+import (
+	"encoding/json"
+	"github.com/go-test/deep"
+	"github.com/mdhender/ottomap/internal/diagnostics"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCollector(t *testing.T) {
+	// a nil collector logs the diagnostic and counts nothing
+	var nilCollector *diagnostics.Collector_t
+	nilCollector.Add(&diagnostics.Diagnostic_t{Severity: diagnostics.Error, Message: "logged"})
+	nilCollector.Sort()
+	if nilCollector.Errors() != 0 || nilCollector.Warnings() != 0 {
+		t.Errorf("nil: want 0 errors and 0 warnings, got %d and %d", nilCollector.Errors(), nilCollector.Warnings())
+	}
+
+	dx := diagnostics.New()
+	for _, d := range []*diagnostics.Diagnostic_t{
+		{Severity: diagnostics.Warning, TurnId: "0900-02", ReportId: "0900-02.0991", Line: 4, Message: "d"},
+		{Severity: diagnostics.Error, TurnId: "0900-01", ReportId: "0900-01.0991", Line: 9, Column: 2, Message: "c"},
+		{Severity: diagnostics.Info, TurnId: "0900-01", UnitId: "0991e1", Message: "a"},
+		{Severity: diagnostics.Error, TurnId: "0900-01", ReportId: "0900-01.0991", Line: 9, Column: 1, Message: "b"},
+		{Severity: diagnostics.Warning, TurnId: "0900-01", ReportId: "0900-01.0992", Line: 1, Message: "e"},
+	} {
+		dx.Add(d)
+	}
+	if dx.Errors() != 2 || dx.Warnings() != 2 {
+		t.Errorf("counts: want 2 errors and 2 warnings, got %d and %d", dx.Errors(), dx.Warnings())
+	}
+
+	// sorted by turn, report, line, column, and unit
+	dx.Sort()
+	var got string
+	for _, d := range dx.Diagnostics {
+		got += d.Message
+	}
+	if want := "abced"; got != want {
+		t.Errorf("sort: want %q, got %q", want, got)
+	}
+}
+
+func TestSaveEmpty(t *testing.T) {
+	// the file is written for a clean run so that readers can tell it was clean
+	for _, tc := range []struct {
+		id string
+		dx *diagnostics.Collector_t
+	}{
+		{id: "nil", dx: nil},
+		{id: "empty", dx: diagnostics.New()},
+	} {
+		path := filepath.Join(t.TempDir(), "0991.errors.json")
+		if err := tc.dx.Save(path); err != nil {
+			t.Errorf("%s: save: %v", tc.id, err)
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Errorf("%s: read: %v", tc.id, err)
+		} else if want := "{\n\t\"errors\": 0,\n\t\"warnings\": 0,\n\t\"diagnostics\": []\n}"; string(data) != want {
+			t.Errorf("%s: want %q, got %q", tc.id, want, string(data))
+		}
+	}
+}
+
+// the errors file is read by other tools, so the field names and
+// severities must not change.
+func TestSaveRoundTrip(t *testing.T) {
+	dx := diagnostics.New()
+	dx.Add(&diagnostics.Diagnostic_t{Severity: diagnostics.Warning, ReportId: "0900-01.0991", TurnId: "0900-01", UnitId: "0991", Message: "conflicting terrain"})
+	dx.Add(&diagnostics.Diagnostic_t{
+		Severity: diagnostics.Error,
+		ReportId: "0900-01.0991",
+		File:     "0900-01.0991.report.txt",
+		TurnId:   "0900-01",
+		UnitId:   "0991",
+		Line:     17,
+		Column:   33,
+		Message:  "error parsing step",
+		Expected: []string{`"AH"`, `"TU"`},
+		Text:     `Tribe Movement: Move SE-GH, \N`,
+		Fix:      "check the spelling",
+	})
+	dx.Add(&diagnostics.Diagnostic_t{Severity: diagnostics.Info, TurnId: "0900-02", Message: "new unit"})
+	path := filepath.Join(t.TempDir(), "0991.errors.json")
+	if err := dx.Save(path); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+
+	// check the names in the file, not just that the struct decodes
+	var raw struct {
+		Errors      int              `json:"errors"`
+		Warnings    int              `json:"warnings"`
+		Diagnostics []map[string]any `json:"diagnostics"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("json: %v", err)
+	} else if raw.Errors != 1 || raw.Warnings != 1 || len(raw.Diagnostics) != 3 {
+		t.Fatalf("json: want 1 error, 1 warning, 3 diagnostics, got %d, %d, %d", raw.Errors, raw.Warnings, len(raw.Diagnostics))
+	}
+	// the error sorts after the warning since it has a line number
+	want := map[string]any{
+		"severity": "error",
+		"reportId": "0900-01.0991",
+		"file":     "0900-01.0991.report.txt",
+		"turnId":   "0900-01",
+		"unitId":   "0991",
+		"line":     float64(17),
+		"column":   float64(33),
+		"message":  "error parsing step",
+		"expected": []any{`"AH"`, `"TU"`},
+		"text":     `Tribe Movement: Move SE-GH, \N`,
+		"fix":      "check the spelling",
+	}
+	if diff := deep.Equal(raw.Diagnostics[1], want); diff != nil {
+		for _, d := range diff {
+			t.Errorf("error: %s", d)
+		}
+	}
+	for n, severity := range []string{"warning", "error", "info"} {
+		if got := raw.Diagnostics[n]["severity"]; got != severity {
+			t.Errorf("diagnostic %d: severity: want %q, got %v", n+1, severity, got)
+		}
+	}
+	// fields that don't apply are left out
+	if _, ok := raw.Diagnostics[0]["line"]; ok {
+		t.Errorf("warning: want no line, got %v", raw.Diagnostics[0]["line"])
+	}
+
+	// and the file decodes back to the same diagnostics
+	var r diagnostics.Report_t
+	if err := json.Unmarshal(data, &r); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if diff := deep.Equal(r.Diagnostics, dx.Diagnostics); diff != nil {
+		for _, d := range diff {
+			t.Errorf("decode: %s", d)
+		}
+	}
+}
@@ -33,6 +33,17 @@ var Directions = []Direction_e{
 	NorthWest,
 }
 
+// Opposite is the direction that undoes a move in the direction.
+// It is also the direction of a border as seen from the neighboring hex.
+var Opposite = map[Direction_e]Direction_e{
+	North:     South,
+	NorthEast: SouthWest,
+	SouthEast: NorthWest,
+	South:     North,
+	SouthWest: NorthEast,
+	NorthWest: SouthEast,
+}
+
 // MarshalJSON implements the json.Marshaler interface.
 func (d Direction_e) MarshalJSON() ([]byte, error) {
 	return json.Marshal(EnumToString[d])
@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package parser
+
+import (
+	"bytes"
+	"errors"
+	"github.com/mdhender/ottomap/internal/diagnostics"
+)
+
+// Error_t is returned when the parser can't make sense of part of a line.
+// It records the text that failed so that the caller can find the column
+// of the problem in the original line.
+type Error_t struct {
+	Text     []byte   // the text that failed to parse
+	Column   int      // column in Text where the problem was found, indexed from 1
+	Expected []string // tokens the grammar expected at the column, if known
+	Err      error
+}
+
+func (e *Error_t) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error_t) Unwrap() error {
+	return e.Err
+}
+
+// newError returns an Error_t for the text.
+// If the error came from the generated parser, the column is taken from it.
+func newError(text []byte, err error) *Error_t {
+	e := &Error_t{Text: bdup(text), Column: 1, Err: err}
+	var list errList
+	if errors.As(err, &list) && len(list) != 0 {
+		err = list[0]
+	}
+	var pe *parserError
+	if errors.As(err, &pe) {
+		e.Column = pe.pos.col
+		e.Expected = pe.expected
+		if pe.Inner != nil {
+			e.Err = pe.Inner
+		}
+	}
+	return e
+}
+
+// diagnose adds the error to the collector. It uses the details from Error_t,
+// if available, to find the column in the line where the problem starts.
+func diagnose(dx *diagnostics.Collector_t, fid, tid string, unitId UnitId_t, lineNo int, line []byte, err error, fix string) {
+	d := &diagnostics.Diagnostic_t{
+		Severity: diagnostics.Error,
+		ReportId: fid,
+		TurnId:   tid,
+		UnitId:   string(unitId),
+		Line:     lineNo,
+		Message:  err.Error(),
+		Text:     string(line),
+		Fix:      fix,
+	}
+	var pe *Error_t
+	if errors.As(err, &pe) {
+		if offset := bytes.Index(line, pe.Text); offset != -1 {
+			d.Column = offset + pe.Column
+		}
+		d.Expected = pe.Expected
+	}
+	dx.Add(d)
+}
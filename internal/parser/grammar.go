@@ -39,7 +39,10 @@ func (d Date_t) IsZero() bool {
 
 type TurnInfo_t struct {
 	CurrentTurn Date_t
+	Season      string
+	Weather     string
 	NextTurn    Date_t
+	ReportDate  Date_t // date printed after the next turn
 }
 
 func bdup(src []byte) []byte {
@@ -59,24 +62,24 @@ var g = &grammar{
 	rules: []*rule{
 		{
 			name: "Noop",
-			pos:  position{line: 59, col: 1, offset: 1016},
+			pos:  position{line: 62, col: 1, offset: 1121},
 			expr: &actionExpr{
-				pos: position{line: 59, col: 9, offset: 1024},
+				pos: position{line: 62, col: 9, offset: 1129},
 				run: (*parser).callonNoop1,
 				expr: &ruleRefExpr{
-					pos:  position{line: 59, col: 9, offset: 1024},
+					pos:  position{line: 62, col: 9, offset: 1129},
 					name: "EOF",
 				},
 			},
 		},
 		{
 			name: "AdminNote",
-			pos:  position{line: 63, col: 1, offset: 1054},
+			pos:  position{line: 66, col: 1, offset: 1159},
 			expr: &actionExpr{
-				pos: position{line: 63, col: 14, offset: 1067},
+				pos: position{line: 66, col: 14, offset: 1172},
 				run: (*parser).callonAdminNote1,
 				expr: &litMatcher{
-					pos:        position{line: 63, col: 14, offset: 1067},
+					pos:        position{line: 66, col: 14, offset: 1172},
 					val:        "Map Testing",
 					ignoreCase: false,
 					want:       "\"Map Testing\"",
@@ -85,45 +88,45 @@ var g = &grammar{
 		},
 		{
 			name: "CrowsNestObservation",
-			pos:  position{line: 67, col: 1, offset: 1107},
+			pos:  position{line: 70, col: 1, offset: 1212},
 			expr: &actionExpr{
-				pos: position{line: 67, col: 25, offset: 1131},
+				pos: position{line: 70, col: 25, offset: 1236},
 				run: (*parser).callonCrowsNestObservation1,
 				expr: &seqExpr{
-					pos: position{line: 67, col: 25, offset: 1131},
+					pos: position{line: 70, col: 25, offset: 1236},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 67, col: 25, offset: 1131},
+							pos:   position{line: 70, col: 25, offset: 1236},
 							label: "cs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 67, col: 28, offset: 1134},
+								pos:  position{line: 70, col: 28, offset: 1239},
 								name: "CROWSIGHTING",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 67, col: 41, offset: 1147},
+							pos:  position{line: 70, col: 41, offset: 1252},
 							name: "SP",
 						},
 						&litMatcher{
-							pos:        position{line: 67, col: 44, offset: 1150},
+							pos:        position{line: 70, col: 44, offset: 1255},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 67, col: 48, offset: 1154},
+							pos:  position{line: 70, col: 48, offset: 1259},
 							name: "SP",
 						},
 						&labeledExpr{
-							pos:   position{line: 67, col: 51, offset: 1157},
+							pos:   position{line: 70, col: 51, offset: 1262},
 							label: "cp",
 							expr: &ruleRefExpr{
-								pos:  position{line: 67, col: 54, offset: 1160},
+								pos:  position{line: 70, col: 54, offset: 1265},
 								name: "COMPASSPOINT",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 67, col: 67, offset: 1173},
+							pos:  position{line: 70, col: 67, offset: 1278},
 							name: "EOF",
 						},
 					},
@@ -132,30 +135,30 @@ var g = &grammar{
 		},
 		{
 			name: "DeckObservation",
-			pos:  position{line: 74, col: 1, offset: 1295},
+			pos:  position{line: 77, col: 1, offset: 1400},
 			expr: &actionExpr{
-				pos: position{line: 74, col: 20, offset: 1314},
+				pos: position{line: 77, col: 20, offset: 1419},
 				run: (*parser).callonDeckObservation1,
 				expr: &seqExpr{
-					pos: position{line: 74, col: 20, offset: 1314},
+					pos: position{line: 77, col: 20, offset: 1419},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 74, col: 20, offset: 1314},
+							pos:   position{line: 77, col: 20, offset: 1419},
 							label: "d",
 							expr: &ruleRefExpr{
-								pos:  position{line: 74, col: 22, offset: 1316},
+								pos:  position{line: 77, col: 22, offset: 1421},
 								name: "DIRECTION",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 74, col: 32, offset: 1326},
+							pos:  position{line: 77, col: 32, offset: 1431},
 							name: "SP",
 						},
 						&labeledExpr{
-							pos:   position{line: 74, col: 35, offset: 1329},
+							pos:   position{line: 77, col: 35, offset: 1434},
 							label: "t",
 							expr: &ruleRefExpr{
-								pos:  position{line: 74, col: 37, offset: 1331},
+								pos:  position{line: 77, col: 37, offset: 1436},
 								name: "TERRAIN_CODE",
 							},
 						},
@@ -165,45 +168,45 @@ var g = &grammar{
 		},
 		{
 			name: "EdgeType",
-			pos:  position{line: 81, col: 1, offset: 1468},
+			pos:  position{line: 84, col: 1, offset: 1573},
 			expr: &choiceExpr{
-				pos: position{line: 81, col: 13, offset: 1480},
+				pos: position{line: 84, col: 13, offset: 1585},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 81, col: 13, offset: 1480},
+						pos: position{line: 84, col: 13, offset: 1585},
 						run: (*parser).callonEdgeType2,
 						expr: &litMatcher{
-							pos:        position{line: 81, col: 13, offset: 1480},
+							pos:        position{line: 84, col: 13, offset: 1585},
 							val:        "Ford",
 							ignoreCase: false,
 							want:       "\"Ford\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 83, col: 5, offset: 1520},
+						pos: position{line: 86, col: 5, offset: 1625},
 						run: (*parser).callonEdgeType4,
 						expr: &litMatcher{
-							pos:        position{line: 83, col: 5, offset: 1520},
+							pos:        position{line: 86, col: 5, offset: 1625},
 							val:        "Pass",
 							ignoreCase: false,
 							want:       "\"Pass\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 85, col: 5, offset: 1560},
+						pos: position{line: 88, col: 5, offset: 1665},
 						run: (*parser).callonEdgeType6,
 						expr: &litMatcher{
-							pos:        position{line: 85, col: 5, offset: 1560},
+							pos:        position{line: 88, col: 5, offset: 1665},
 							val:        "River",
 							ignoreCase: false,
 							want:       "\"River\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 87, col: 5, offset: 1602},
+						pos: position{line: 90, col: 5, offset: 1707},
 						run: (*parser).callonEdgeType8,
 						expr: &litMatcher{
-							pos:        position{line: 87, col: 5, offset: 1602},
+							pos:        position{line: 90, col: 5, offset: 1707},
 							val:        "Stone Road",
 							ignoreCase: false,
 							want:       "\"Stone Road\"",
@@ -214,57 +217,57 @@ var g = &grammar{
 		},
 		{
 			name: "FleetMovement",
-			pos:  position{line: 91, col: 1, offset: 1652},
+			pos:  position{line: 94, col: 1, offset: 1757},
 			expr: &actionExpr{
-				pos: position{line: 91, col: 18, offset: 1669},
+				pos: position{line: 94, col: 18, offset: 1774},
 				run: (*parser).callonFleetMovement1,
 				expr: &seqExpr{
-					pos: position{line: 91, col: 18, offset: 1669},
+					pos: position{line: 94, col: 18, offset: 1774},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 91, col: 18, offset: 1669},
+							pos:   position{line: 94, col: 18, offset: 1774},
 							label: "ws",
 							expr: &ruleRefExpr{
-								pos:  position{line: 91, col: 21, offset: 1672},
+								pos:  position{line: 94, col: 21, offset: 1777},
 								name: "WINDSTRENGTH",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 91, col: 34, offset: 1685},
+							pos:  position{line: 94, col: 34, offset: 1790},
 							name: "SP",
 						},
 						&labeledExpr{
-							pos:   position{line: 91, col: 37, offset: 1688},
+							pos:   position{line: 94, col: 37, offset: 1793},
 							label: "d",
 							expr: &ruleRefExpr{
-								pos:  position{line: 91, col: 39, offset: 1690},
+								pos:  position{line: 94, col: 39, offset: 1795},
 								name: "DIRECTION",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 91, col: 49, offset: 1700},
+							pos:  position{line: 94, col: 49, offset: 1805},
 							name: "SP",
 						},
 						&litMatcher{
-							pos:        position{line: 91, col: 52, offset: 1703},
+							pos:        position{line: 94, col: 52, offset: 1808},
 							val:        "Fleet Movement:",
 							ignoreCase: false,
 							want:       "\"Fleet Movement:\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 91, col: 70, offset: 1721},
+							pos:  position{line: 94, col: 70, offset: 1826},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 91, col: 72, offset: 1723},
+							pos:   position{line: 94, col: 72, offset: 1828},
 							label: "results",
 							expr: &ruleRefExpr{
-								pos:  position{line: 91, col: 80, offset: 1731},
+								pos:  position{line: 94, col: 80, offset: 1836},
 								name: "ToEOL",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 91, col: 86, offset: 1737},
+							pos:  position{line: 94, col: 86, offset: 1842},
 							name: "EOF",
 						},
 					},
@@ -273,42 +276,42 @@ var g = &grammar{
 		},
 		{
 			name: "Location",
-			pos:  position{line: 101, col: 1, offset: 1976},
+			pos:  position{line: 104, col: 1, offset: 2081},
 			expr: &actionExpr{
-				pos: position{line: 101, col: 13, offset: 1988},
+				pos: position{line: 104, col: 13, offset: 2093},
 				run: (*parser).callonLocation1,
 				expr: &seqExpr{
-					pos: position{line: 101, col: 13, offset: 1988},
+					pos: position{line: 104, col: 13, offset: 2093},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 101, col: 14, offset: 1989},
+							pos: position{line: 104, col: 14, offset: 2094},
 							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 101, col: 14, offset: 1989},
+									pos:        position{line: 104, col: 14, offset: 2094},
 									val:        "Courier",
 									ignoreCase: false,
 									want:       "\"Courier\"",
 								},
 								&litMatcher{
-									pos:        position{line: 101, col: 26, offset: 2001},
+									pos:        position{line: 104, col: 26, offset: 2106},
 									val:        "Element",
 									ignoreCase: false,
 									want:       "\"Element\"",
 								},
 								&litMatcher{
-									pos:        position{line: 101, col: 38, offset: 2013},
+									pos:        position{line: 104, col: 38, offset: 2118},
 									val:        "Fleet",
 									ignoreCase: false,
 									want:       "\"Fleet\"",
 								},
 								&litMatcher{
-									pos:        position{line: 101, col: 48, offset: 2023},
+									pos:        position{line: 104, col: 48, offset: 2128},
 									val:        "Garrison",
 									ignoreCase: false,
 									want:       "\"Garrison\"",
 								},
 								&litMatcher{
-									pos:        position{line: 101, col: 61, offset: 2036},
+									pos:        position{line: 104, col: 61, offset: 2141},
 									val:        "Tribe",
 									ignoreCase: false,
 									want:       "\"Tribe\"",
@@ -316,102 +319,102 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 101, col: 70, offset: 2045},
+							pos:  position{line: 104, col: 70, offset: 2150},
 							name: "SP",
 						},
 						&labeledExpr{
-							pos:   position{line: 101, col: 73, offset: 2048},
+							pos:   position{line: 104, col: 73, offset: 2153},
 							label: "u",
 							expr: &ruleRefExpr{
-								pos:  position{line: 101, col: 75, offset: 2050},
+								pos:  position{line: 104, col: 75, offset: 2155},
 								name: "UNIT_ID",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 101, col: 83, offset: 2058},
+							pos:        position{line: 104, col: 83, offset: 2163},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 101, col: 87, offset: 2062},
+							pos:  position{line: 104, col: 87, offset: 2167},
 							name: "SP",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 101, col: 90, offset: 2065},
+							pos: position{line: 104, col: 90, offset: 2170},
 							expr: &ruleRefExpr{
-								pos:  position{line: 101, col: 90, offset: 2065},
+								pos:  position{line: 104, col: 90, offset: 2170},
 								name: "AdminNote",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 101, col: 101, offset: 2076},
+							pos:        position{line: 104, col: 101, offset: 2181},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 101, col: 105, offset: 2080},
+							pos:  position{line: 104, col: 105, offset: 2185},
 							name: "SP",
 						},
 						&litMatcher{
-							pos:        position{line: 101, col: 108, offset: 2083},
+							pos:        position{line: 104, col: 108, offset: 2188},
 							val:        "Current Hex =",
 							ignoreCase: false,
 							want:       "\"Current Hex =\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 101, col: 124, offset: 2099},
+							pos:  position{line: 104, col: 124, offset: 2204},
 							name: "SP",
 						},
 						&labeledExpr{
-							pos:   position{line: 101, col: 127, offset: 2102},
+							pos:   position{line: 104, col: 127, offset: 2207},
 							label: "ch",
 							expr: &ruleRefExpr{
-								pos:  position{line: 101, col: 130, offset: 2105},
+								pos:  position{line: 104, col: 130, offset: 2210},
 								name: "COORDS",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 101, col: 137, offset: 2112},
+							pos:        position{line: 104, col: 137, offset: 2217},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 101, col: 141, offset: 2116},
+							pos:  position{line: 104, col: 141, offset: 2221},
 							name: "SP",
 						},
 						&litMatcher{
-							pos:        position{line: 101, col: 144, offset: 2119},
+							pos:        position{line: 104, col: 144, offset: 2224},
 							val:        "(Previous Hex =",
 							ignoreCase: false,
 							want:       "\"(Previous Hex =\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 101, col: 162, offset: 2137},
+							pos:  position{line: 104, col: 162, offset: 2242},
 							name: "SP",
 						},
 						&labeledExpr{
-							pos:   position{line: 101, col: 165, offset: 2140},
+							pos:   position{line: 104, col: 165, offset: 2245},
 							label: "ph",
 							expr: &ruleRefExpr{
-								pos:  position{line: 101, col: 168, offset: 2143},
+								pos:  position{line: 104, col: 168, offset: 2248},
 								name: "COORDS",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 101, col: 175, offset: 2150},
+							pos:        position{line: 104, col: 175, offset: 2255},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 101, col: 179, offset: 2154},
+							pos:  position{line: 104, col: 179, offset: 2259},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 101, col: 181, offset: 2156},
+							pos:  position{line: 104, col: 181, offset: 2261},
 							name: "EOF",
 						},
 					},
@@ -420,58 +423,58 @@ var g = &grammar{
 		},
 		{
 			name: "Longhouse",
-			pos:  position{line: 109, col: 1, offset: 2303},
+			pos:  position{line: 112, col: 1, offset: 2408},
 			expr: &actionExpr{
-				pos: position{line: 109, col: 14, offset: 2316},
+				pos: position{line: 112, col: 14, offset: 2421},
 				run: (*parser).callonLonghouse1,
 				expr: &seqExpr{
-					pos: position{line: 109, col: 14, offset: 2316},
+					pos: position{line: 112, col: 14, offset: 2421},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 109, col: 14, offset: 2316},
+							pos:   position{line: 112, col: 14, offset: 2421},
 							label: "szi",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 109, col: 19, offset: 2321},
+								pos: position{line: 112, col: 19, offset: 2426},
 								expr: &ruleRefExpr{
-									pos:  position{line: 109, col: 19, offset: 2321},
+									pos:  position{line: 112, col: 19, offset: 2426},
 									name: "DIGIT",
 								},
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 109, col: 27, offset: 2329},
+							pos: position{line: 112, col: 27, offset: 2434},
 							expr: &ruleRefExpr{
-								pos:  position{line: 109, col: 27, offset: 2329},
+								pos:  position{line: 112, col: 27, offset: 2434},
 								name: "SP",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 109, col: 31, offset: 2333},
+							pos:        position{line: 112, col: 31, offset: 2438},
 							val:        "Longhouse",
 							ignoreCase: false,
 							want:       "\"Longhouse\"",
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 109, col: 43, offset: 2345},
+							pos: position{line: 112, col: 43, offset: 2450},
 							expr: &ruleRefExpr{
-								pos:  position{line: 109, col: 43, offset: 2345},
+								pos:  position{line: 112, col: 43, offset: 2450},
 								name: "SP",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 109, col: 47, offset: 2349},
+							pos:   position{line: 112, col: 47, offset: 2454},
 							label: "idi",
 							expr: &seqExpr{
-								pos: position{line: 109, col: 52, offset: 2354},
+								pos: position{line: 112, col: 52, offset: 2459},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 109, col: 52, offset: 2354},
+										pos:  position{line: 112, col: 52, offset: 2459},
 										name: "LETTER",
 									},
 									&oneOrMoreExpr{
-										pos: position{line: 109, col: 59, offset: 2361},
+										pos: position{line: 112, col: 59, offset: 2466},
 										expr: &ruleRefExpr{
-											pos:  position{line: 109, col: 59, offset: 2361},
+											pos:  position{line: 112, col: 59, offset: 2466},
 											name: "DIGIT",
 										},
 									},
@@ -484,65 +487,65 @@ var g = &grammar{
 		},
 		{
 			name: "ObviousNeighboringTerrainCode",
-			pos:  position{line: 145, col: 1, offset: 3382},
+			pos:  position{line: 148, col: 1, offset: 3487},
 			expr: &choiceExpr{
-				pos: position{line: 145, col: 34, offset: 3415},
+				pos: position{line: 148, col: 34, offset: 3520},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 145, col: 34, offset: 3415},
+						pos: position{line: 148, col: 34, offset: 3520},
 						run: (*parser).callonObviousNeighboringTerrainCode2,
 						expr: &litMatcher{
-							pos:        position{line: 145, col: 34, offset: 3415},
+							pos:        position{line: 148, col: 34, offset: 3520},
 							val:        "hsm",
 							ignoreCase: true,
 							want:       "\"HSM\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 147, col: 5, offset: 3471},
+						pos: position{line: 150, col: 5, offset: 3576},
 						run: (*parser).callonObviousNeighboringTerrainCode4,
 						expr: &litMatcher{
-							pos:        position{line: 147, col: 5, offset: 3471},
+							pos:        position{line: 150, col: 5, offset: 3576},
 							val:        "lcm",
 							ignoreCase: true,
 							want:       "\"LCM\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 149, col: 5, offset: 3528},
+						pos: position{line: 152, col: 5, offset: 3633},
 						run: (*parser).callonObviousNeighboringTerrainCode6,
 						expr: &litMatcher{
-							pos:        position{line: 149, col: 5, offset: 3528},
+							pos:        position{line: 152, col: 5, offset: 3633},
 							val:        "ljm",
 							ignoreCase: true,
 							want:       "\"LJM\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 151, col: 5, offset: 3584},
+						pos: position{line: 154, col: 5, offset: 3689},
 						run: (*parser).callonObviousNeighboringTerrainCode8,
 						expr: &litMatcher{
-							pos:        position{line: 151, col: 5, offset: 3584},
+							pos:        position{line: 154, col: 5, offset: 3689},
 							val:        "lsm",
 							ignoreCase: true,
 							want:       "\"LSM\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 153, col: 5, offset: 3639},
+						pos: position{line: 156, col: 5, offset: 3744},
 						run: (*parser).callonObviousNeighboringTerrainCode10,
 						expr: &litMatcher{
-							pos:        position{line: 153, col: 5, offset: 3639},
+							pos:        position{line: 156, col: 5, offset: 3744},
 							val:        "L",
 							ignoreCase: false,
 							want:       "\"L\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 155, col: 5, offset: 3678},
+						pos: position{line: 158, col: 5, offset: 3783},
 						run: (*parser).callonObviousNeighboringTerrainCode12,
 						expr: &litMatcher{
-							pos:        position{line: 155, col: 5, offset: 3678},
+							pos:        position{line: 158, col: 5, offset: 3783},
 							val:        "O",
 							ignoreCase: false,
 							want:       "\"O\"",
@@ -553,25 +556,25 @@ var g = &grammar{
 		},
 		{
 			name: "ProhibitedBy",
-			pos:  position{line: 159, col: 1, offset: 3717},
+			pos:  position{line: 162, col: 1, offset: 3822},
 			expr: &choiceExpr{
-				pos: position{line: 159, col: 17, offset: 3733},
+				pos: position{line: 162, col: 17, offset: 3838},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 159, col: 17, offset: 3733},
+						pos: position{line: 162, col: 17, offset: 3838},
 						run: (*parser).callonProhibitedBy2,
 						expr: &litMatcher{
-							pos:        position{line: 159, col: 17, offset: 3733},
+							pos:        position{line: 162, col: 17, offset: 3838},
 							val:        "Lake",
 							ignoreCase: false,
 							want:       "\"Lake\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 161, col: 5, offset: 3775},
+						pos: position{line: 164, col: 5, offset: 3880},
 						run: (*parser).callonProhibitedBy4,
 						expr: &litMatcher{
-							pos:        position{line: 161, col: 5, offset: 3775},
+							pos:        position{line: 164, col: 5, offset: 3880},
 							val:        "Ocean",
 							ignoreCase: false,
 							want:       "\"Ocean\"",
@@ -582,28 +585,28 @@ var g = &grammar{
 		},
 		{
 			name: "ScoutMovement",
-			pos:  position{line: 165, col: 1, offset: 3818},
+			pos:  position{line: 168, col: 1, offset: 3923},
 			expr: &actionExpr{
-				pos: position{line: 165, col: 18, offset: 3835},
+				pos: position{line: 168, col: 18, offset: 3940},
 				run: (*parser).callonScoutMovement1,
 				expr: &seqExpr{
-					pos: position{line: 165, col: 18, offset: 3835},
+					pos: position{line: 168, col: 18, offset: 3940},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 165, col: 18, offset: 3835},
+							pos:        position{line: 168, col: 18, offset: 3940},
 							val:        "Scout",
 							ignoreCase: false,
 							want:       "\"Scout\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 165, col: 26, offset: 3843},
+							pos:  position{line: 168, col: 26, offset: 3948},
 							name: "SP",
 						},
 						&labeledExpr{
-							pos:   position{line: 165, col: 29, offset: 3846},
+							pos:   position{line: 168, col: 29, offset: 3951},
 							label: "no",
 							expr: &charClassMatcher{
-								pos:        position{line: 165, col: 32, offset: 3849},
+								pos:        position{line: 168, col: 32, offset: 3954},
 								val:        "[1-8]",
 								ranges:     []rune{'1', '8'},
 								ignoreCase: false,
@@ -611,25 +614,25 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 165, col: 38, offset: 3855},
+							pos:        position{line: 168, col: 38, offset: 3960},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 165, col: 42, offset: 3859},
+							pos:  position{line: 168, col: 42, offset: 3964},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 165, col: 44, offset: 3861},
+							pos:   position{line: 168, col: 44, offset: 3966},
 							label: "results",
 							expr: &ruleRefExpr{
-								pos:  position{line: 165, col: 52, offset: 3869},
+								pos:  position{line: 168, col: 52, offset: 3974},
 								name: "ToEOL",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 165, col: 58, offset: 3875},
+							pos:  position{line: 168, col: 58, offset: 3980},
 							name: "EOF",
 						},
 					},
@@ -638,22 +641,22 @@ var g = &grammar{
 		},
 		{
 			name: "SpaceDirection",
-			pos:  position{line: 182, col: 1, offset: 4282},
+			pos:  position{line: 185, col: 1, offset: 4387},
 			expr: &actionExpr{
-				pos: position{line: 182, col: 19, offset: 4300},
+				pos: position{line: 185, col: 19, offset: 4405},
 				run: (*parser).callonSpaceDirection1,
 				expr: &seqExpr{
-					pos: position{line: 182, col: 19, offset: 4300},
+					pos: position{line: 185, col: 19, offset: 4405},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 182, col: 19, offset: 4300},
+							pos:  position{line: 185, col: 19, offset: 4405},
 							name: "SP",
 						},
 						&labeledExpr{
-							pos:   position{line: 182, col: 22, offset: 4303},
+							pos:   position{line: 185, col: 22, offset: 4408},
 							label: "d",
 							expr: &ruleRefExpr{
-								pos:  position{line: 182, col: 24, offset: 4305},
+								pos:  position{line: 185, col: 24, offset: 4410},
 								name: "DIRECTION",
 							},
 						},
@@ -663,22 +666,22 @@ var g = &grammar{
 		},
 		{
 			name: "SpaceUnitID",
-			pos:  position{line: 186, col: 1, offset: 4338},
+			pos:  position{line: 189, col: 1, offset: 4443},
 			expr: &actionExpr{
-				pos: position{line: 186, col: 16, offset: 4353},
+				pos: position{line: 189, col: 16, offset: 4458},
 				run: (*parser).callonSpaceUnitID1,
 				expr: &seqExpr{
-					pos: position{line: 186, col: 16, offset: 4353},
+					pos: position{line: 189, col: 16, offset: 4458},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 186, col: 16, offset: 4353},
+							pos:  position{line: 189, col: 16, offset: 4458},
 							name: "SP",
 						},
 						&labeledExpr{
-							pos:   position{line: 186, col: 19, offset: 4356},
+							pos:   position{line: 189, col: 19, offset: 4461},
 							label: "u",
 							expr: &ruleRefExpr{
-								pos:  position{line: 186, col: 21, offset: 4358},
+								pos:  position{line: 189, col: 21, offset: 4463},
 								name: "UNIT_ID",
 							},
 						},
@@ -688,45 +691,45 @@ var g = &grammar{
 		},
 		{
 			name: "StatusLine",
-			pos:  position{line: 190, col: 1, offset: 4389},
+			pos:  position{line: 193, col: 1, offset: 4494},
 			expr: &actionExpr{
-				pos: position{line: 190, col: 15, offset: 4403},
+				pos: position{line: 193, col: 15, offset: 4508},
 				run: (*parser).callonStatusLine1,
 				expr: &seqExpr{
-					pos: position{line: 190, col: 15, offset: 4403},
+					pos: position{line: 193, col: 15, offset: 4508},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 190, col: 15, offset: 4403},
+							pos:   position{line: 193, col: 15, offset: 4508},
 							label: "u",
 							expr: &ruleRefExpr{
-								pos:  position{line: 190, col: 17, offset: 4405},
+								pos:  position{line: 193, col: 17, offset: 4510},
 								name: "UNIT_ID",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 190, col: 25, offset: 4413},
+							pos:  position{line: 193, col: 25, offset: 4518},
 							name: "SP",
 						},
 						&litMatcher{
-							pos:        position{line: 190, col: 28, offset: 4416},
+							pos:        position{line: 193, col: 28, offset: 4521},
 							val:        "Status:",
 							ignoreCase: false,
 							want:       "\"Status:\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 190, col: 38, offset: 4426},
+							pos:  position{line: 193, col: 38, offset: 4531},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 190, col: 40, offset: 4428},
+							pos:   position{line: 193, col: 40, offset: 4533},
 							label: "results",
 							expr: &ruleRefExpr{
-								pos:  position{line: 190, col: 48, offset: 4436},
+								pos:  position{line: 193, col: 48, offset: 4541},
 								name: "ToEOL",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 190, col: 54, offset: 4442},
+							pos:  position{line: 193, col: 54, offset: 4547},
 							name: "EOF",
 						},
 					},
@@ -735,305 +738,305 @@ var g = &grammar{
 		},
 		{
 			name: "Step",
-			pos:  position{line: 201, col: 1, offset: 4635},
+			pos:  position{line: 204, col: 1, offset: 4740},
 			expr: &choiceExpr{
-				pos: position{line: 201, col: 9, offset: 4643},
+				pos: position{line: 204, col: 9, offset: 4748},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 201, col: 9, offset: 4643},
+						pos: position{line: 204, col: 9, offset: 4748},
 						run: (*parser).callonStep2,
 						expr: &seqExpr{
-							pos: position{line: 201, col: 9, offset: 4643},
+							pos: position{line: 204, col: 9, offset: 4748},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 201, col: 9, offset: 4643},
+									pos:   position{line: 204, col: 9, offset: 4748},
 									label: "d",
 									expr: &ruleRefExpr{
-										pos:  position{line: 201, col: 11, offset: 4645},
+										pos:  position{line: 204, col: 11, offset: 4750},
 										name: "DIRECTION",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 201, col: 21, offset: 4655},
+									pos:        position{line: 204, col: 21, offset: 4760},
 									val:        "-",
 									ignoreCase: false,
 									want:       "\"-\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 201, col: 25, offset: 4659},
+									pos:   position{line: 204, col: 25, offset: 4764},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 201, col: 27, offset: 4661},
+										pos:  position{line: 204, col: 27, offset: 4766},
 										name: "TERRAIN_CODE",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 201, col: 40, offset: 4674},
+									pos:  position{line: 204, col: 40, offset: 4779},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 206, col: 5, offset: 4814},
+						pos: position{line: 209, col: 5, offset: 4919},
 						run: (*parser).callonStep10,
 						expr: &seqExpr{
-							pos: position{line: 206, col: 5, offset: 4814},
+							pos: position{line: 209, col: 5, offset: 4919},
 							exprs: []any{
 								&charClassMatcher{
-									pos:        position{line: 206, col: 5, offset: 4814},
+									pos:        position{line: 209, col: 5, offset: 4919},
 									val:        "[Cc]",
 									chars:      []rune{'C', 'c'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 206, col: 10, offset: 4819},
+									pos:        position{line: 209, col: 10, offset: 4924},
 									val:        "an't Move on",
 									ignoreCase: false,
 									want:       "\"an't Move on\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 206, col: 25, offset: 4834},
+									pos:  position{line: 209, col: 25, offset: 4939},
 									name: "SP",
 								},
 								&labeledExpr{
-									pos:   position{line: 206, col: 28, offset: 4837},
+									pos:   position{line: 209, col: 28, offset: 4942},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 206, col: 30, offset: 4839},
+										pos:  position{line: 209, col: 30, offset: 4944},
 										name: "ProhibitedBy",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 206, col: 43, offset: 4852},
+									pos:  position{line: 209, col: 43, offset: 4957},
 									name: "SP",
 								},
 								&litMatcher{
-									pos:        position{line: 206, col: 46, offset: 4855},
+									pos:        position{line: 209, col: 46, offset: 4960},
 									val:        "to",
 									ignoreCase: false,
 									want:       "\"to\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 206, col: 51, offset: 4860},
+									pos:  position{line: 209, col: 51, offset: 4965},
 									name: "SP",
 								},
 								&labeledExpr{
-									pos:   position{line: 206, col: 54, offset: 4863},
+									pos:   position{line: 209, col: 54, offset: 4968},
 									label: "d",
 									expr: &ruleRefExpr{
-										pos:  position{line: 206, col: 56, offset: 4865},
+										pos:  position{line: 209, col: 56, offset: 4970},
 										name: "DIRECTION",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 206, col: 66, offset: 4875},
+									pos:  position{line: 209, col: 66, offset: 4980},
 									name: "SP",
 								},
 								&litMatcher{
-									pos:        position{line: 206, col: 69, offset: 4878},
+									pos:        position{line: 209, col: 69, offset: 4983},
 									val:        "of HEX",
 									ignoreCase: false,
 									want:       "\"of HEX\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 206, col: 78, offset: 4887},
+									pos:  position{line: 209, col: 78, offset: 4992},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 211, col: 5, offset: 5026},
+						pos: position{line: 214, col: 5, offset: 5131},
 						run: (*parser).callonStep25,
 						expr: &seqExpr{
-							pos: position{line: 211, col: 5, offset: 5026},
+							pos: position{line: 214, col: 5, offset: 5131},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 211, col: 5, offset: 5026},
+									pos:        position{line: 214, col: 5, offset: 5131},
 									val:        "Group did not return",
 									ignoreCase: false,
 									want:       "\"Group did not return\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 211, col: 28, offset: 5049},
+									pos:  position{line: 214, col: 28, offset: 5154},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 213, col: 5, offset: 5092},
+						pos: position{line: 216, col: 5, offset: 5197},
 						run: (*parser).callonStep29,
 						expr: &seqExpr{
-							pos: position{line: 213, col: 5, offset: 5092},
+							pos: position{line: 216, col: 5, offset: 5197},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 213, col: 5, offset: 5092},
+									pos:        position{line: 216, col: 5, offset: 5197},
 									val:        "Find",
 									ignoreCase: false,
 									want:       "\"Find\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 213, col: 12, offset: 5099},
+									pos:  position{line: 216, col: 12, offset: 5204},
 									name: "SP",
 								},
 								&labeledExpr{
-									pos:   position{line: 213, col: 15, offset: 5102},
+									pos:   position{line: 216, col: 15, offset: 5207},
 									label: "r",
 									expr: &ruleRefExpr{
-										pos:  position{line: 213, col: 17, offset: 5104},
+										pos:  position{line: 216, col: 17, offset: 5209},
 										name: "RESOURCE",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 213, col: 26, offset: 5113},
+									pos:  position{line: 216, col: 26, offset: 5218},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 215, col: 5, offset: 5141},
+						pos: position{line: 218, col: 5, offset: 5246},
 						run: (*parser).callonStep36,
 						expr: &seqExpr{
-							pos: position{line: 215, col: 5, offset: 5141},
+							pos: position{line: 218, col: 5, offset: 5246},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 215, col: 5, offset: 5141},
+									pos:        position{line: 218, col: 5, offset: 5246},
 									val:        "Find",
 									ignoreCase: false,
 									want:       "\"Find\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 215, col: 12, offset: 5148},
+									pos:  position{line: 218, col: 12, offset: 5253},
 									name: "SP",
 								},
 								&labeledExpr{
-									pos:   position{line: 215, col: 15, offset: 5151},
+									pos:   position{line: 218, col: 15, offset: 5256},
 									label: "n",
 									expr: &ruleRefExpr{
-										pos:  position{line: 215, col: 17, offset: 5153},
+										pos:  position{line: 218, col: 17, offset: 5258},
 										name: "NUMBER",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 215, col: 24, offset: 5160},
+									pos:  position{line: 218, col: 24, offset: 5265},
 									name: "SP",
 								},
 								&labeledExpr{
-									pos:   position{line: 215, col: 27, offset: 5163},
+									pos:   position{line: 218, col: 27, offset: 5268},
 									label: "i",
 									expr: &ruleRefExpr{
-										pos:  position{line: 215, col: 29, offset: 5165},
+										pos:  position{line: 218, col: 29, offset: 5270},
 										name: "ITEM",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 215, col: 34, offset: 5170},
+									pos:  position{line: 218, col: 34, offset: 5275},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 220, col: 5, offset: 5278},
+						pos: position{line: 223, col: 5, offset: 5383},
 						run: (*parser).callonStep46,
 						expr: &seqExpr{
-							pos: position{line: 220, col: 5, offset: 5278},
+							pos: position{line: 223, col: 5, offset: 5383},
 							exprs: []any{
 								&charClassMatcher{
-									pos:        position{line: 220, col: 5, offset: 5278},
+									pos:        position{line: 223, col: 5, offset: 5383},
 									val:        "[Nn]",
 									chars:      []rune{'N', 'n'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 220, col: 10, offset: 5283},
+									pos:        position{line: 223, col: 10, offset: 5388},
 									val:        "o Ford on River to",
 									ignoreCase: false,
 									want:       "\"o Ford on River to\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 220, col: 31, offset: 5304},
+									pos:  position{line: 223, col: 31, offset: 5409},
 									name: "SP",
 								},
 								&labeledExpr{
-									pos:   position{line: 220, col: 34, offset: 5307},
+									pos:   position{line: 223, col: 34, offset: 5412},
 									label: "d",
 									expr: &ruleRefExpr{
-										pos:  position{line: 220, col: 36, offset: 5309},
+										pos:  position{line: 223, col: 36, offset: 5414},
 										name: "DIRECTION",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 220, col: 46, offset: 5319},
+									pos:  position{line: 223, col: 46, offset: 5424},
 									name: "SP",
 								},
 								&litMatcher{
-									pos:        position{line: 220, col: 49, offset: 5322},
+									pos:        position{line: 223, col: 49, offset: 5427},
 									val:        "of HEX",
 									ignoreCase: false,
 									want:       "\"of HEX\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 220, col: 58, offset: 5331},
+									pos:  position{line: 223, col: 58, offset: 5436},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 225, col: 5, offset: 5459},
+						pos: position{line: 228, col: 5, offset: 5564},
 						run: (*parser).callonStep56,
 						expr: &seqExpr{
-							pos: position{line: 225, col: 5, offset: 5459},
+							pos: position{line: 228, col: 5, offset: 5564},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 225, col: 5, offset: 5459},
+									pos:        position{line: 228, col: 5, offset: 5564},
 									val:        "No groups found",
 									ignoreCase: false,
 									want:       "\"No groups found\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 225, col: 23, offset: 5477},
+									pos:  position{line: 228, col: 23, offset: 5582},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 227, col: 5, offset: 5521},
+						pos: position{line: 230, col: 5, offset: 5626},
 						run: (*parser).callonStep60,
 						expr: &seqExpr{
-							pos: position{line: 227, col: 5, offset: 5521},
+							pos: position{line: 230, col: 5, offset: 5626},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 227, col: 5, offset: 5521},
+									pos:        position{line: 230, col: 5, offset: 5626},
 									val:        "No River Adjacent to Hex to",
 									ignoreCase: false,
 									want:       "\"No River Adjacent to Hex to\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 227, col: 35, offset: 5551},
+									pos:  position{line: 230, col: 35, offset: 5656},
 									name: "SP",
 								},
 								&labeledExpr{
-									pos:   position{line: 227, col: 38, offset: 5554},
+									pos:   position{line: 230, col: 38, offset: 5659},
 									label: "d",
 									expr: &ruleRefExpr{
-										pos:  position{line: 227, col: 40, offset: 5556},
+										pos:  position{line: 230, col: 40, offset: 5661},
 										name: "DIRECTION",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 227, col: 50, offset: 5566},
+									pos:  position{line: 230, col: 50, offset: 5671},
 									name: "SP",
 								},
 								&litMatcher{
-									pos:        position{line: 227, col: 53, offset: 5569},
+									pos:        position{line: 230, col: 53, offset: 5674},
 									val:        "of HEX",
 									ignoreCase: false,
 									want:       "\"of HEX\"",
@@ -1042,368 +1045,368 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 231, col: 5, offset: 5667},
+						pos: position{line: 234, col: 5, offset: 5772},
 						run: (*parser).callonStep68,
 						expr: &seqExpr{
-							pos: position{line: 231, col: 5, offset: 5667},
+							pos: position{line: 234, col: 5, offset: 5772},
 							exprs: []any{
 								&charClassMatcher{
-									pos:        position{line: 231, col: 5, offset: 5667},
+									pos:        position{line: 234, col: 5, offset: 5772},
 									val:        "[Nn]",
 									chars:      []rune{'N', 'n'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 231, col: 10, offset: 5672},
+									pos:        position{line: 234, col: 10, offset: 5777},
 									val:        "ot enough M.P's to move to",
 									ignoreCase: false,
 									want:       "\"ot enough M.P's to move to\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 231, col: 39, offset: 5701},
+									pos:  position{line: 234, col: 39, offset: 5806},
 									name: "SP",
 								},
 								&labeledExpr{
-									pos:   position{line: 231, col: 42, offset: 5704},
+									pos:   position{line: 234, col: 42, offset: 5809},
 									label: "d",
 									expr: &ruleRefExpr{
-										pos:  position{line: 231, col: 44, offset: 5706},
+										pos:  position{line: 234, col: 44, offset: 5811},
 										name: "DIRECTION",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 231, col: 54, offset: 5716},
+									pos:  position{line: 234, col: 54, offset: 5821},
 									name: "SP",
 								},
 								&litMatcher{
-									pos:        position{line: 231, col: 57, offset: 5719},
+									pos:        position{line: 234, col: 57, offset: 5824},
 									val:        "into",
 									ignoreCase: false,
 									want:       "\"into\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 231, col: 64, offset: 5726},
+									pos:  position{line: 234, col: 64, offset: 5831},
 									name: "SP",
 								},
 								&labeledExpr{
-									pos:   position{line: 231, col: 67, offset: 5729},
+									pos:   position{line: 234, col: 67, offset: 5834},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 231, col: 69, offset: 5731},
+										pos:  position{line: 234, col: 69, offset: 5836},
 										name: "TERRAIN",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 231, col: 77, offset: 5739},
+									pos:  position{line: 234, col: 77, offset: 5844},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 236, col: 5, offset: 5873},
+						pos: position{line: 239, col: 5, offset: 5978},
 						run: (*parser).callonStep81,
 						expr: &seqExpr{
-							pos: position{line: 236, col: 5, offset: 5873},
+							pos: position{line: 239, col: 5, offset: 5978},
 							exprs: []any{
 								&charClassMatcher{
-									pos:        position{line: 236, col: 5, offset: 5873},
+									pos:        position{line: 239, col: 5, offset: 5978},
 									val:        "[Nn]",
 									chars:      []rune{'N', 'n'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 236, col: 10, offset: 5878},
+									pos:        position{line: 239, col: 10, offset: 5983},
 									val:        "othing of interest found",
 									ignoreCase: false,
 									want:       "\"othing of interest found\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 236, col: 37, offset: 5905},
+									pos:  position{line: 239, col: 37, offset: 6010},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 238, col: 5, offset: 5948},
+						pos: position{line: 241, col: 5, offset: 6053},
 						run: (*parser).callonStep86,
 						expr: &seqExpr{
-							pos: position{line: 238, col: 5, offset: 5948},
+							pos: position{line: 241, col: 5, offset: 6053},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 238, col: 5, offset: 5948},
+									pos:        position{line: 241, col: 5, offset: 6053},
 									val:        "Patrolled and found",
 									ignoreCase: false,
 									want:       "\"Patrolled and found\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 238, col: 27, offset: 5970},
+									pos:  position{line: 241, col: 27, offset: 6075},
 									name: "SP",
 								},
 								&labeledExpr{
-									pos:   position{line: 238, col: 30, offset: 5973},
+									pos:   position{line: 241, col: 30, offset: 6078},
 									label: "u",
 									expr: &ruleRefExpr{
-										pos:  position{line: 238, col: 32, offset: 5975},
+										pos:  position{line: 241, col: 32, offset: 6080},
 										name: "UNIT_ID",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 238, col: 40, offset: 5983},
+									pos:   position{line: 241, col: 40, offset: 6088},
 									label: "sui",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 238, col: 44, offset: 5987},
+										pos: position{line: 241, col: 44, offset: 6092},
 										expr: &ruleRefExpr{
-											pos:  position{line: 238, col: 44, offset: 5987},
+											pos:  position{line: 241, col: 44, offset: 6092},
 											name: "SpaceUnitID",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 238, col: 57, offset: 6000},
+									pos:  position{line: 241, col: 57, offset: 6105},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 249, col: 5, offset: 6317},
+						pos: position{line: 252, col: 5, offset: 6436},
 						run: (*parser).callonStep96,
 						expr: &seqExpr{
-							pos: position{line: 249, col: 5, offset: 6317},
+							pos: position{line: 252, col: 5, offset: 6436},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 249, col: 5, offset: 6317},
+									pos:   position{line: 252, col: 5, offset: 6436},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 249, col: 7, offset: 6319},
+										pos:  position{line: 252, col: 7, offset: 6438},
 										name: "ObviousNeighboringTerrainCode",
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 249, col: 37, offset: 6349},
+									pos: position{line: 252, col: 37, offset: 6468},
 									expr: &ruleRefExpr{
-										pos:  position{line: 249, col: 37, offset: 6349},
+										pos:  position{line: 252, col: 37, offset: 6468},
 										name: "SP",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 249, col: 41, offset: 6353},
+									pos:   position{line: 252, col: 41, offset: 6472},
 									label: "d",
 									expr: &ruleRefExpr{
-										pos:  position{line: 249, col: 43, offset: 6355},
+										pos:  position{line: 252, col: 43, offset: 6474},
 										name: "DIRECTION",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 249, col: 53, offset: 6365},
+									pos:   position{line: 252, col: 53, offset: 6484},
 									label: "sdi",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 249, col: 57, offset: 6369},
+										pos: position{line: 252, col: 57, offset: 6488},
 										expr: &ruleRefExpr{
-											pos:  position{line: 249, col: 57, offset: 6369},
+											pos:  position{line: 252, col: 57, offset: 6488},
 											name: "SpaceDirection",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 249, col: 73, offset: 6385},
+									pos:  position{line: 252, col: 73, offset: 6504},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 264, col: 5, offset: 6847},
+						pos: position{line: 267, col: 5, offset: 6966},
 						run: (*parser).callonStep108,
 						expr: &seqExpr{
-							pos: position{line: 264, col: 5, offset: 6847},
+							pos: position{line: 267, col: 5, offset: 6966},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 264, col: 5, offset: 6847},
+									pos:   position{line: 267, col: 5, offset: 6966},
 									label: "et",
 									expr: &ruleRefExpr{
-										pos:  position{line: 264, col: 8, offset: 6850},
+										pos:  position{line: 267, col: 8, offset: 6969},
 										name: "EdgeType",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 264, col: 17, offset: 6859},
+									pos:  position{line: 267, col: 17, offset: 6978},
 									name: "SP",
 								},
 								&labeledExpr{
-									pos:   position{line: 264, col: 20, offset: 6862},
+									pos:   position{line: 267, col: 20, offset: 6981},
 									label: "d",
 									expr: &ruleRefExpr{
-										pos:  position{line: 264, col: 22, offset: 6864},
+										pos:  position{line: 267, col: 22, offset: 6983},
 										name: "DIRECTION",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 264, col: 32, offset: 6874},
+									pos:   position{line: 267, col: 32, offset: 6993},
 									label: "edi",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 264, col: 36, offset: 6878},
+										pos: position{line: 267, col: 36, offset: 6997},
 										expr: &ruleRefExpr{
-											pos:  position{line: 264, col: 36, offset: 6878},
+											pos:  position{line: 267, col: 36, offset: 6997},
 											name: "SpaceDirection",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 264, col: 52, offset: 6894},
+									pos:  position{line: 267, col: 52, offset: 7013},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 276, col: 5, offset: 7304},
+						pos: position{line: 279, col: 5, offset: 7423},
 						run: (*parser).callonStep119,
 						expr: &seqExpr{
-							pos: position{line: 276, col: 5, offset: 7304},
+							pos: position{line: 279, col: 5, offset: 7423},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 276, col: 5, offset: 7304},
+									pos:   position{line: 279, col: 5, offset: 7423},
 									label: "n",
 									expr: &ruleRefExpr{
-										pos:  position{line: 276, col: 7, offset: 7306},
+										pos:  position{line: 279, col: 7, offset: 7425},
 										name: "NUMBER",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 276, col: 14, offset: 7313},
+									pos:  position{line: 279, col: 14, offset: 7432},
 									name: "SP",
 								},
 								&labeledExpr{
-									pos:   position{line: 276, col: 17, offset: 7316},
+									pos:   position{line: 279, col: 17, offset: 7435},
 									label: "i",
 									expr: &ruleRefExpr{
-										pos:  position{line: 276, col: 19, offset: 7318},
+										pos:  position{line: 279, col: 19, offset: 7437},
 										name: "ITEM",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 276, col: 24, offset: 7323},
+									pos:  position{line: 279, col: 24, offset: 7442},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 281, col: 5, offset: 7431},
+						pos: position{line: 284, col: 5, offset: 7550},
 						run: (*parser).callonStep127,
 						expr: &seqExpr{
-							pos: position{line: 281, col: 5, offset: 7431},
+							pos: position{line: 284, col: 5, offset: 7550},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 281, col: 5, offset: 7431},
+									pos:   position{line: 284, col: 5, offset: 7550},
 									label: "u",
 									expr: &ruleRefExpr{
-										pos:  position{line: 281, col: 7, offset: 7433},
+										pos:  position{line: 284, col: 7, offset: 7552},
 										name: "UNIT_ID",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 281, col: 15, offset: 7441},
+									pos:   position{line: 284, col: 15, offset: 7560},
 									label: "sui",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 281, col: 19, offset: 7445},
+										pos: position{line: 284, col: 19, offset: 7564},
 										expr: &ruleRefExpr{
-											pos:  position{line: 281, col: 19, offset: 7445},
+											pos:  position{line: 284, col: 19, offset: 7564},
 											name: "SpaceUnitID",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 281, col: 32, offset: 7458},
+									pos:  position{line: 284, col: 32, offset: 7577},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 292, col: 5, offset: 7775},
+						pos: position{line: 295, col: 5, offset: 7894},
 						run: (*parser).callonStep135,
 						expr: &seqExpr{
-							pos: position{line: 292, col: 5, offset: 7775},
+							pos: position{line: 295, col: 5, offset: 7894},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 292, col: 5, offset: 7775},
+									pos:   position{line: 295, col: 5, offset: 7894},
 									label: "lh",
 									expr: &ruleRefExpr{
-										pos:  position{line: 292, col: 8, offset: 7778},
+										pos:  position{line: 295, col: 8, offset: 7897},
 										name: "Longhouse",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 292, col: 18, offset: 7788},
+									pos:  position{line: 295, col: 18, offset: 7907},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 294, col: 5, offset: 7817},
+						pos: position{line: 297, col: 5, offset: 7936},
 						run: (*parser).callonStep140,
 						expr: &seqExpr{
-							pos: position{line: 294, col: 5, offset: 7817},
+							pos: position{line: 297, col: 5, offset: 7936},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 294, col: 5, offset: 7817},
+									pos:   position{line: 297, col: 5, offset: 7936},
 									label: "r",
 									expr: &ruleRefExpr{
-										pos:  position{line: 294, col: 7, offset: 7819},
+										pos:  position{line: 297, col: 7, offset: 7938},
 										name: "RESOURCE",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 294, col: 16, offset: 7828},
+									pos:  position{line: 297, col: 16, offset: 7947},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 296, col: 5, offset: 7856},
+						pos: position{line: 299, col: 5, offset: 7975},
 						run: (*parser).callonStep145,
 						expr: &seqExpr{
-							pos: position{line: 296, col: 5, offset: 7856},
+							pos: position{line: 299, col: 5, offset: 7975},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 296, col: 5, offset: 7856},
+									pos:   position{line: 299, col: 5, offset: 7975},
 									label: "d",
 									expr: &ruleRefExpr{
-										pos:  position{line: 296, col: 7, offset: 7858},
+										pos:  position{line: 299, col: 7, offset: 7977},
 										name: "DIRECTION",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 296, col: 17, offset: 7868},
+									pos:  position{line: 299, col: 17, offset: 7987},
 									name: "EOF",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 298, col: 5, offset: 7896},
+						pos: position{line: 301, col: 5, offset: 8015},
 						run: (*parser).callonStep150,
 						expr: &seqExpr{
-							pos: position{line: 298, col: 5, offset: 7896},
+							pos: position{line: 301, col: 5, offset: 8015},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 298, col: 5, offset: 7896},
+									pos:   position{line: 301, col: 5, offset: 8015},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 298, col: 7, offset: 7898},
+										pos:  position{line: 301, col: 7, offset: 8017},
 										name: "TERRAIN",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 298, col: 15, offset: 7906},
+									pos:  position{line: 301, col: 15, offset: 8025},
 									name: "EOF",
 								},
 							},
@@ -1414,37 +1417,37 @@ var g = &grammar{
 		},
 		{
 			name: "TribeFollows",
-			pos:  position{line: 302, col: 1, offset: 7933},
+			pos:  position{line: 305, col: 1, offset: 8052},
 			expr: &actionExpr{
-				pos: position{line: 302, col: 17, offset: 7949},
+				pos: position{line: 305, col: 17, offset: 8068},
 				run: (*parser).callonTribeFollows1,
 				expr: &seqExpr{
-					pos: position{line: 302, col: 17, offset: 7949},
+					pos: position{line: 305, col: 17, offset: 8068},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 302, col: 17, offset: 7949},
+							pos:        position{line: 305, col: 17, offset: 8068},
 							val:        "Tribe Follows",
 							ignoreCase: false,
 							want:       "\"Tribe Follows\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 302, col: 33, offset: 7965},
+							pos:  position{line: 305, col: 33, offset: 8084},
 							name: "SP",
 						},
 						&labeledExpr{
-							pos:   position{line: 302, col: 36, offset: 7968},
+							pos:   position{line: 305, col: 36, offset: 8087},
 							label: "u",
 							expr: &ruleRefExpr{
-								pos:  position{line: 302, col: 38, offset: 7970},
+								pos:  position{line: 305, col: 38, offset: 8089},
 								name: "UNIT_ID",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 302, col: 46, offset: 7978},
+							pos:  position{line: 305, col: 46, offset: 8097},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 302, col: 48, offset: 7980},
+							pos:  position{line: 305, col: 48, offset: 8099},
 							name: "EOF",
 						},
 					},
@@ -1453,37 +1456,37 @@ var g = &grammar{
 		},
 		{
 			name: "TribeGoesTo",
-			pos:  position{line: 307, col: 1, offset: 8081},
+			pos:  position{line: 310, col: 1, offset: 8200},
 			expr: &actionExpr{
-				pos: position{line: 307, col: 16, offset: 8096},
+				pos: position{line: 310, col: 16, offset: 8215},
 				run: (*parser).callonTribeGoesTo1,
 				expr: &seqExpr{
-					pos: position{line: 307, col: 16, offset: 8096},
+					pos: position{line: 310, col: 16, offset: 8215},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 307, col: 16, offset: 8096},
+							pos:        position{line: 310, col: 16, offset: 8215},
 							val:        "Tribe Goes to",
 							ignoreCase: false,
 							want:       "\"Tribe Goes to\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 307, col: 32, offset: 8112},
+							pos:  position{line: 310, col: 32, offset: 8231},
 							name: "SP",
 						},
 						&labeledExpr{
-							pos:   position{line: 307, col: 35, offset: 8115},
+							pos:   position{line: 310, col: 35, offset: 8234},
 							label: "h",
 							expr: &ruleRefExpr{
-								pos:  position{line: 307, col: 37, offset: 8117},
+								pos:  position{line: 310, col: 37, offset: 8236},
 								name: "COORDS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 307, col: 44, offset: 8124},
+							pos:  position{line: 310, col: 44, offset: 8243},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 307, col: 46, offset: 8126},
+							pos:  position{line: 310, col: 46, offset: 8245},
 							name: "EOF",
 						},
 					},
@@ -1492,33 +1495,33 @@ var g = &grammar{
 		},
 		{
 			name: "TribeMovement",
-			pos:  position{line: 312, col: 1, offset: 8223},
+			pos:  position{line: 315, col: 1, offset: 8342},
 			expr: &actionExpr{
-				pos: position{line: 312, col: 18, offset: 8240},
+				pos: position{line: 315, col: 18, offset: 8359},
 				run: (*parser).callonTribeMovement1,
 				expr: &seqExpr{
-					pos: position{line: 312, col: 18, offset: 8240},
+					pos: position{line: 315, col: 18, offset: 8359},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 312, col: 18, offset: 8240},
+							pos:        position{line: 315, col: 18, offset: 8359},
 							val:        "Tribe Movement:",
 							ignoreCase: false,
 							want:       "\"Tribe Movement:\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 312, col: 36, offset: 8258},
+							pos:  position{line: 315, col: 36, offset: 8377},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 312, col: 38, offset: 8260},
+							pos:   position{line: 315, col: 38, offset: 8379},
 							label: "results",
 							expr: &ruleRefExpr{
-								pos:  position{line: 312, col: 46, offset: 8268},
+								pos:  position{line: 315, col: 46, offset: 8387},
 								name: "ToEOL",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 312, col: 52, offset: 8274},
+							pos:  position{line: 315, col: 52, offset: 8393},
 							name: "EOF",
 						},
 					},
@@ -1527,66 +1530,74 @@ var g = &grammar{
 		},
 		{
 			name: "TurnInfo",
-			pos:  position{line: 320, col: 1, offset: 8421},
+			pos:  position{line: 323, col: 1, offset: 8540},
 			expr: &actionExpr{
-				pos: position{line: 320, col: 13, offset: 8433},
+				pos: position{line: 323, col: 13, offset: 8552},
 				run: (*parser).callonTurnInfo1,
 				expr: &seqExpr{
-					pos: position{line: 320, col: 13, offset: 8433},
+					pos: position{line: 323, col: 13, offset: 8552},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 320, col: 13, offset: 8433},
+							pos:   position{line: 323, col: 13, offset: 8552},
 							label: "cd",
 							expr: &ruleRefExpr{
-								pos:  position{line: 320, col: 16, offset: 8436},
+								pos:  position{line: 323, col: 16, offset: 8555},
 								name: "CurrentTurn",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 320, col: 28, offset: 8448},
+							pos:        position{line: 323, col: 28, offset: 8567},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 320, col: 32, offset: 8452},
+							pos:  position{line: 323, col: 32, offset: 8571},
 							name: "SP",
 						},
-						&ruleRefExpr{
-							pos:  position{line: 320, col: 35, offset: 8455},
-							name: "TurnSeason",
+						&labeledExpr{
+							pos:   position{line: 323, col: 35, offset: 8574},
+							label: "ts",
+							expr: &ruleRefExpr{
+								pos:  position{line: 323, col: 38, offset: 8577},
+								name: "TurnSeason",
+							},
 						},
 						&litMatcher{
-							pos:        position{line: 320, col: 46, offset: 8466},
+							pos:        position{line: 323, col: 49, offset: 8588},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 320, col: 50, offset: 8470},
+							pos:  position{line: 323, col: 53, offset: 8592},
 							name: "SP",
 						},
-						&ruleRefExpr{
-							pos:  position{line: 320, col: 53, offset: 8473},
-							name: "TurnWeather",
+						&labeledExpr{
+							pos:   position{line: 323, col: 56, offset: 8595},
+							label: "tw",
+							expr: &ruleRefExpr{
+								pos:  position{line: 323, col: 59, offset: 8598},
+								name: "TurnWeather",
+							},
 						},
 						&labeledExpr{
-							pos:   position{line: 320, col: 65, offset: 8485},
+							pos:   position{line: 323, col: 71, offset: 8610},
 							label: "nt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 320, col: 68, offset: 8488},
+								pos: position{line: 323, col: 74, offset: 8613},
 								expr: &ruleRefExpr{
-									pos:  position{line: 320, col: 68, offset: 8488},
+									pos:  position{line: 323, col: 74, offset: 8613},
 									name: "NextTurn",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 320, col: 78, offset: 8498},
+							pos:  position{line: 323, col: 84, offset: 8623},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 320, col: 80, offset: 8500},
+							pos:  position{line: 323, col: 86, offset: 8625},
 							name: "EOF",
 						},
 					},
@@ -1595,50 +1606,50 @@ var g = &grammar{
 		},
 		{
 			name: "CurrentTurn",
-			pos:  position{line: 333, col: 1, offset: 8718},
+			pos:  position{line: 336, col: 1, offset: 8913},
 			expr: &actionExpr{
-				pos: position{line: 333, col: 16, offset: 8733},
+				pos: position{line: 336, col: 16, offset: 8928},
 				run: (*parser).callonCurrentTurn1,
 				expr: &seqExpr{
-					pos: position{line: 333, col: 16, offset: 8733},
+					pos: position{line: 336, col: 16, offset: 8928},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 333, col: 16, offset: 8733},
+							pos:        position{line: 336, col: 16, offset: 8928},
 							val:        "Current Turn",
 							ignoreCase: false,
 							want:       "\"Current Turn\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 333, col: 31, offset: 8748},
+							pos:  position{line: 336, col: 31, offset: 8943},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 333, col: 33, offset: 8750},
+							pos:   position{line: 336, col: 33, offset: 8945},
 							label: "cd",
 							expr: &ruleRefExpr{
-								pos:  position{line: 333, col: 36, offset: 8753},
+								pos:  position{line: 336, col: 36, offset: 8948},
 								name: "YearMonth",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 333, col: 46, offset: 8763},
+							pos:  position{line: 336, col: 46, offset: 8958},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 333, col: 48, offset: 8765},
+							pos:        position{line: 336, col: 48, offset: 8960},
 							val:        "(#",
 							ignoreCase: false,
 							want:       "\"(#\"",
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 333, col: 53, offset: 8770},
+							pos: position{line: 336, col: 53, offset: 8965},
 							expr: &ruleRefExpr{
-								pos:  position{line: 333, col: 53, offset: 8770},
+								pos:  position{line: 336, col: 53, offset: 8965},
 								name: "DIGIT",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 333, col: 60, offset: 8777},
+							pos:        position{line: 336, col: 60, offset: 8972},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -1649,65 +1660,69 @@ var g = &grammar{
 		},
 		{
 			name: "NextTurn",
-			pos:  position{line: 337, col: 1, offset: 8805},
+			pos:  position{line: 340, col: 1, offset: 9000},
 			expr: &actionExpr{
-				pos: position{line: 337, col: 13, offset: 8817},
+				pos: position{line: 340, col: 13, offset: 9012},
 				run: (*parser).callonNextTurn1,
 				expr: &seqExpr{
-					pos: position{line: 337, col: 13, offset: 8817},
+					pos: position{line: 340, col: 13, offset: 9012},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 337, col: 13, offset: 8817},
+							pos:  position{line: 340, col: 13, offset: 9012},
 							name: "SP",
 						},
 						&litMatcher{
-							pos:        position{line: 337, col: 16, offset: 8820},
+							pos:        position{line: 340, col: 16, offset: 9015},
 							val:        "Next Turn",
 							ignoreCase: false,
 							want:       "\"Next Turn\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 337, col: 28, offset: 8832},
+							pos:  position{line: 340, col: 28, offset: 9027},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 337, col: 30, offset: 8834},
+							pos:   position{line: 340, col: 30, offset: 9029},
 							label: "nd",
 							expr: &ruleRefExpr{
-								pos:  position{line: 337, col: 33, offset: 8837},
+								pos:  position{line: 340, col: 33, offset: 9032},
 								name: "YearMonth",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 337, col: 43, offset: 8847},
+							pos:  position{line: 340, col: 43, offset: 9042},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 337, col: 45, offset: 8849},
+							pos:        position{line: 340, col: 45, offset: 9044},
 							val:        "(#",
 							ignoreCase: false,
 							want:       "\"(#\"",
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 337, col: 50, offset: 8854},
+							pos: position{line: 340, col: 50, offset: 9049},
 							expr: &ruleRefExpr{
-								pos:  position{line: 337, col: 50, offset: 8854},
+								pos:  position{line: 340, col: 50, offset: 9049},
 								name: "DIGIT",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 337, col: 57, offset: 8861},
+							pos:        position{line: 340, col: 57, offset: 9056},
 							val:        "),",
 							ignoreCase: false,
 							want:       "\"),\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 337, col: 62, offset: 8866},
+							pos:  position{line: 340, col: 62, offset: 9061},
 							name: "_",
 						},
-						&ruleRefExpr{
-							pos:  position{line: 337, col: 64, offset: 8868},
-							name: "ReportDate",
+						&labeledExpr{
+							pos:   position{line: 340, col: 64, offset: 9063},
+							label: "rd",
+							expr: &ruleRefExpr{
+								pos:  position{line: 340, col: 67, offset: 9066},
+								name: "ReportDate",
+							},
 						},
 					},
 				},
@@ -1715,62 +1730,48 @@ var g = &grammar{
 		},
 		{
 			name: "ReportDate",
-			pos:  position{line: 341, col: 1, offset: 8903},
+			pos:  position{line: 347, col: 1, offset: 9180},
 			expr: &actionExpr{
-				pos: position{line: 341, col: 15, offset: 8917},
+				pos: position{line: 347, col: 15, offset: 9194},
 				run: (*parser).callonReportDate1,
 				expr: &seqExpr{
-					pos: position{line: 341, col: 15, offset: 8917},
+					pos: position{line: 347, col: 15, offset: 9194},
 					exprs: []any{
-						&ruleRefExpr{
-							pos:  position{line: 341, col: 15, offset: 8917},
-							name: "DIGIT",
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 341, col: 21, offset: 8923},
+						&labeledExpr{
+							pos:   position{line: 347, col: 15, offset: 9194},
+							label: "d",
 							expr: &ruleRefExpr{
-								pos:  position{line: 341, col: 21, offset: 8923},
-								name: "DIGIT",
+								pos:  position{line: 347, col: 17, offset: 9196},
+								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 341, col: 28, offset: 8930},
+							pos:        position{line: 347, col: 24, offset: 9203},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
-						&ruleRefExpr{
-							pos:  position{line: 341, col: 32, offset: 8934},
-							name: "DIGIT",
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 341, col: 38, offset: 8940},
+						&labeledExpr{
+							pos:   position{line: 347, col: 28, offset: 9207},
+							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 341, col: 38, offset: 8940},
-								name: "DIGIT",
+								pos:  position{line: 347, col: 30, offset: 9209},
+								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 341, col: 45, offset: 8947},
+							pos:        position{line: 347, col: 37, offset: 9216},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
-						&ruleRefExpr{
-							pos:  position{line: 341, col: 49, offset: 8951},
-							name: "DIGIT",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 341, col: 55, offset: 8957},
-							name: "DIGIT",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 341, col: 61, offset: 8963},
-							name: "DIGIT",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 341, col: 67, offset: 8969},
-							name: "DIGIT",
+						&labeledExpr{
+							pos:   position{line: 347, col: 41, offset: 9220},
+							label: "y",
+							expr: &ruleRefExpr{
+								pos:  position{line: 347, col: 43, offset: 9222},
+								name: "NUMBER",
+							},
 						},
 					},
 				},
@@ -1778,21 +1779,21 @@ var g = &grammar{
 		},
 		{
 			name: "ToEOL",
-			pos:  position{line: 346, col: 1, offset: 9047},
+			pos:  position{line: 355, col: 1, offset: 9336},
 			expr: &actionExpr{
-				pos: position{line: 346, col: 10, offset: 9056},
+				pos: position{line: 355, col: 10, offset: 9345},
 				run: (*parser).callonToEOL1,
 				expr: &seqExpr{
-					pos: position{line: 346, col: 10, offset: 9056},
+					pos: position{line: 355, col: 10, offset: 9345},
 					exprs: []any{
 						&zeroOrMoreExpr{
-							pos: position{line: 346, col: 10, offset: 9056},
+							pos: position{line: 355, col: 10, offset: 9345},
 							expr: &anyMatcher{
-								line: 346, col: 10, offset: 9056,
+								line: 355, col: 10, offset: 9345,
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 346, col: 13, offset: 9059},
+							pos:  position{line: 355, col: 13, offset: 9348},
 							name: "EOF",
 						},
 					},
@@ -1801,24 +1802,24 @@ var g = &grammar{
 		},
 		{
 			name: "TurnSeason",
-			pos:  position{line: 350, col: 1, offset: 9091},
+			pos:  position{line: 359, col: 1, offset: 9380},
 			expr: &actionExpr{
-				pos: position{line: 350, col: 15, offset: 9105},
+				pos: position{line: 359, col: 15, offset: 9394},
 				run: (*parser).callonTurnSeason1,
 				expr: &seqExpr{
-					pos: position{line: 350, col: 15, offset: 9105},
+					pos: position{line: 359, col: 15, offset: 9394},
 					exprs: []any{
 						&charClassMatcher{
-							pos:        position{line: 350, col: 15, offset: 9105},
+							pos:        position{line: 359, col: 15, offset: 9394},
 							val:        "[A-Z]",
 							ranges:     []rune{'A', 'Z'},
 							ignoreCase: false,
 							inverted:   false,
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 350, col: 20, offset: 9110},
+							pos: position{line: 359, col: 20, offset: 9399},
 							expr: &charClassMatcher{
-								pos:        position{line: 350, col: 20, offset: 9110},
+								pos:        position{line: 359, col: 20, offset: 9399},
 								val:        "[A-Za-z]",
 								ranges:     []rune{'A', 'Z', 'a', 'z'},
 								ignoreCase: false,
@@ -1831,24 +1832,24 @@ var g = &grammar{
 		},
 		{
 			name: "TurnWeather",
-			pos:  position{line: 355, col: 1, offset: 9192},
+			pos:  position{line: 364, col: 1, offset: 9481},
 			expr: &actionExpr{
-				pos: position{line: 355, col: 16, offset: 9207},
+				pos: position{line: 364, col: 16, offset: 9496},
 				run: (*parser).callonTurnWeather1,
 				expr: &seqExpr{
-					pos: position{line: 355, col: 16, offset: 9207},
+					pos: position{line: 364, col: 16, offset: 9496},
 					exprs: []any{
 						&charClassMatcher{
-							pos:        position{line: 355, col: 16, offset: 9207},
+							pos:        position{line: 364, col: 16, offset: 9496},
 							val:        "[A-Z]",
 							ranges:     []rune{'A', 'Z'},
 							ignoreCase: false,
 							inverted:   false,
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 355, col: 21, offset: 9212},
+							pos: position{line: 364, col: 21, offset: 9501},
 							expr: &charClassMatcher{
-								pos:        position{line: 355, col: 21, offset: 9212},
+								pos:        position{line: 364, col: 21, offset: 9501},
 								val:        "[A-Za-z-]",
 								chars:      []rune{'-'},
 								ranges:     []rune{'A', 'Z', 'a', 'z'},
@@ -1862,32 +1863,32 @@ var g = &grammar{
 		},
 		{
 			name: "YearMonth",
-			pos:  position{line: 360, col: 1, offset: 9296},
+			pos:  position{line: 369, col: 1, offset: 9585},
 			expr: &actionExpr{
-				pos: position{line: 360, col: 14, offset: 9309},
+				pos: position{line: 369, col: 14, offset: 9598},
 				run: (*parser).callonYearMonth1,
 				expr: &seqExpr{
-					pos: position{line: 360, col: 14, offset: 9309},
+					pos: position{line: 369, col: 14, offset: 9598},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 360, col: 14, offset: 9309},
+							pos:   position{line: 369, col: 14, offset: 9598},
 							label: "y",
 							expr: &ruleRefExpr{
-								pos:  position{line: 360, col: 16, offset: 9311},
+								pos:  position{line: 369, col: 16, offset: 9600},
 								name: "YEAR",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 360, col: 21, offset: 9316},
+							pos:        position{line: 369, col: 21, offset: 9605},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 360, col: 25, offset: 9320},
+							pos:   position{line: 369, col: 25, offset: 9609},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 360, col: 27, offset: 9322},
+								pos:  position{line: 369, col: 27, offset: 9611},
 								name: "MONTH",
 							},
 						},
@@ -1897,125 +1898,125 @@ var g = &grammar{
 		},
 		{
 			name: "COMPASSPOINT",
-			pos:  position{line: 367, col: 1, offset: 9412},
+			pos:  position{line: 376, col: 1, offset: 9701},
 			expr: &choiceExpr{
-				pos: position{line: 367, col: 17, offset: 9428},
+				pos: position{line: 376, col: 17, offset: 9717},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 367, col: 17, offset: 9428},
+						pos: position{line: 376, col: 17, offset: 9717},
 						run: (*parser).callonCOMPASSPOINT2,
 						expr: &litMatcher{
-							pos:        position{line: 367, col: 17, offset: 9428},
+							pos:        position{line: 376, col: 17, offset: 9717},
 							val:        "NE/NE",
 							ignoreCase: false,
 							want:       "\"NE/NE\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 369, col: 5, offset: 9476},
+						pos: position{line: 378, col: 5, offset: 9765},
 						run: (*parser).callonCOMPASSPOINT4,
 						expr: &litMatcher{
-							pos:        position{line: 369, col: 5, offset: 9476},
+							pos:        position{line: 378, col: 5, offset: 9765},
 							val:        "NE/SE",
 							ignoreCase: false,
 							want:       "\"NE/SE\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 371, col: 5, offset: 9519},
+						pos: position{line: 380, col: 5, offset: 9808},
 						run: (*parser).callonCOMPASSPOINT6,
 						expr: &litMatcher{
-							pos:        position{line: 371, col: 5, offset: 9519},
+							pos:        position{line: 380, col: 5, offset: 9808},
 							val:        "NW/NW",
 							ignoreCase: false,
 							want:       "\"NW/NW\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 373, col: 5, offset: 9567},
+						pos: position{line: 382, col: 5, offset: 9856},
 						run: (*parser).callonCOMPASSPOINT8,
 						expr: &litMatcher{
-							pos:        position{line: 373, col: 5, offset: 9567},
+							pos:        position{line: 382, col: 5, offset: 9856},
 							val:        "N/NE",
 							ignoreCase: false,
 							want:       "\"N/NE\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 375, col: 5, offset: 9619},
+						pos: position{line: 384, col: 5, offset: 9908},
 						run: (*parser).callonCOMPASSPOINT10,
 						expr: &litMatcher{
-							pos:        position{line: 375, col: 5, offset: 9619},
+							pos:        position{line: 384, col: 5, offset: 9908},
 							val:        "N/NW",
 							ignoreCase: false,
 							want:       "\"N/NW\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 377, col: 5, offset: 9671},
+						pos: position{line: 386, col: 5, offset: 9960},
 						run: (*parser).callonCOMPASSPOINT12,
 						expr: &litMatcher{
-							pos:        position{line: 377, col: 5, offset: 9671},
+							pos:        position{line: 386, col: 5, offset: 9960},
 							val:        "N/N",
 							ignoreCase: false,
 							want:       "\"N/N\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 379, col: 5, offset: 9713},
+						pos: position{line: 388, col: 5, offset: 10002},
 						run: (*parser).callonCOMPASSPOINT14,
 						expr: &litMatcher{
-							pos:        position{line: 379, col: 5, offset: 9713},
+							pos:        position{line: 388, col: 5, offset: 10002},
 							val:        "SE/SE",
 							ignoreCase: false,
 							want:       "\"SE/SE\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 381, col: 5, offset: 9761},
+						pos: position{line: 390, col: 5, offset: 10050},
 						run: (*parser).callonCOMPASSPOINT16,
 						expr: &litMatcher{
-							pos:        position{line: 381, col: 5, offset: 9761},
+							pos:        position{line: 390, col: 5, offset: 10050},
 							val:        "SW/NW",
 							ignoreCase: false,
 							want:       "\"SW/NW\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 383, col: 5, offset: 9804},
+						pos: position{line: 392, col: 5, offset: 10093},
 						run: (*parser).callonCOMPASSPOINT18,
 						expr: &litMatcher{
-							pos:        position{line: 383, col: 5, offset: 9804},
+							pos:        position{line: 392, col: 5, offset: 10093},
 							val:        "SW/SW",
 							ignoreCase: false,
 							want:       "\"SW/SW\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 385, col: 5, offset: 9852},
+						pos: position{line: 394, col: 5, offset: 10141},
 						run: (*parser).callonCOMPASSPOINT20,
 						expr: &litMatcher{
-							pos:        position{line: 385, col: 5, offset: 9852},
+							pos:        position{line: 394, col: 5, offset: 10141},
 							val:        "S/SE",
 							ignoreCase: false,
 							want:       "\"S/SE\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 387, col: 5, offset: 9904},
+						pos: position{line: 396, col: 5, offset: 10193},
 						run: (*parser).callonCOMPASSPOINT22,
 						expr: &litMatcher{
-							pos:        position{line: 387, col: 5, offset: 9904},
+							pos:        position{line: 396, col: 5, offset: 10193},
 							val:        "S/SW",
 							ignoreCase: false,
 							want:       "\"S/SW\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 389, col: 5, offset: 9956},
+						pos: position{line: 398, col: 5, offset: 10245},
 						run: (*parser).callonCOMPASSPOINT24,
 						expr: &litMatcher{
-							pos:        position{line: 389, col: 5, offset: 9956},
+							pos:        position{line: 398, col: 5, offset: 10245},
 							val:        "S/S",
 							ignoreCase: false,
 							want:       "\"S/S\"",
@@ -2026,87 +2027,87 @@ var g = &grammar{
 		},
 		{
 			name: "COORDS",
-			pos:  position{line: 393, col: 1, offset: 9997},
+			pos:  position{line: 402, col: 1, offset: 10286},
 			expr: &choiceExpr{
-				pos: position{line: 393, col: 11, offset: 10007},
+				pos: position{line: 402, col: 11, offset: 10296},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 393, col: 11, offset: 10007},
+						pos: position{line: 402, col: 11, offset: 10296},
 						run: (*parser).callonCOORDS2,
 						expr: &litMatcher{
-							pos:        position{line: 393, col: 11, offset: 10007},
+							pos:        position{line: 402, col: 11, offset: 10296},
 							val:        "N/A",
 							ignoreCase: false,
 							want:       "\"N/A\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 395, col: 5, offset: 10041},
+						pos: position{line: 404, col: 5, offset: 10330},
 						run: (*parser).callonCOORDS4,
 						expr: &seqExpr{
-							pos: position{line: 395, col: 5, offset: 10041},
+							pos: position{line: 404, col: 5, offset: 10330},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 395, col: 5, offset: 10041},
+									pos:        position{line: 404, col: 5, offset: 10330},
 									val:        "##",
 									ignoreCase: false,
 									want:       "\"##\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 395, col: 10, offset: 10046},
+									pos:  position{line: 404, col: 10, offset: 10335},
 									name: "SP",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 395, col: 13, offset: 10049},
+									pos:  position{line: 404, col: 13, offset: 10338},
 									name: "DIGIT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 395, col: 19, offset: 10055},
+									pos:  position{line: 404, col: 19, offset: 10344},
 									name: "DIGIT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 395, col: 25, offset: 10061},
+									pos:  position{line: 404, col: 25, offset: 10350},
 									name: "DIGIT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 395, col: 31, offset: 10067},
+									pos:  position{line: 404, col: 31, offset: 10356},
 									name: "DIGIT",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 397, col: 5, offset: 10110},
+						pos: position{line: 406, col: 5, offset: 10399},
 						run: (*parser).callonCOORDS12,
 						expr: &seqExpr{
-							pos: position{line: 397, col: 5, offset: 10110},
+							pos: position{line: 406, col: 5, offset: 10399},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 397, col: 5, offset: 10110},
+									pos:  position{line: 406, col: 5, offset: 10399},
 									name: "LETTER",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 397, col: 12, offset: 10117},
+									pos:  position{line: 406, col: 12, offset: 10406},
 									name: "LETTER",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 397, col: 19, offset: 10124},
+									pos:  position{line: 406, col: 19, offset: 10413},
 									name: "SP",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 397, col: 22, offset: 10127},
+									pos:  position{line: 406, col: 22, offset: 10416},
 									name: "DIGIT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 397, col: 28, offset: 10133},
+									pos:  position{line: 406, col: 28, offset: 10422},
 									name: "DIGIT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 397, col: 34, offset: 10139},
+									pos:  position{line: 406, col: 34, offset: 10428},
 									name: "DIGIT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 397, col: 40, offset: 10145},
+									pos:  position{line: 406, col: 40, offset: 10434},
 									name: "DIGIT",
 								},
 							},
@@ -2117,25 +2118,25 @@ var g = &grammar{
 		},
 		{
 			name: "CROWSIGHTING",
-			pos:  position{line: 401, col: 1, offset: 10187},
+			pos:  position{line: 410, col: 1, offset: 10476},
 			expr: &choiceExpr{
-				pos: position{line: 401, col: 17, offset: 10203},
+				pos: position{line: 410, col: 17, offset: 10492},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 401, col: 17, offset: 10203},
+						pos: position{line: 410, col: 17, offset: 10492},
 						run: (*parser).callonCROWSIGHTING2,
 						expr: &litMatcher{
-							pos:        position{line: 401, col: 17, offset: 10203},
+							pos:        position{line: 410, col: 17, offset: 10492},
 							val:        "Sight Land",
 							ignoreCase: false,
 							want:       "\"Sight Land\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 403, col: 5, offset: 10258},
+						pos: position{line: 412, col: 5, offset: 10547},
 						run: (*parser).callonCROWSIGHTING4,
 						expr: &litMatcher{
-							pos:        position{line: 403, col: 5, offset: 10258},
+							pos:        position{line: 412, col: 5, offset: 10547},
 							val:        "Sight Water",
 							ignoreCase: false,
 							want:       "\"Sight Water\"",
@@ -2146,65 +2147,65 @@ var g = &grammar{
 		},
 		{
 			name: "DIRECTION",
-			pos:  position{line: 407, col: 1, offset: 10314},
+			pos:  position{line: 416, col: 1, offset: 10603},
 			expr: &choiceExpr{
-				pos: position{line: 407, col: 14, offset: 10327},
+				pos: position{line: 416, col: 14, offset: 10616},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 407, col: 14, offset: 10327},
+						pos: position{line: 416, col: 14, offset: 10616},
 						run: (*parser).callonDIRECTION2,
 						expr: &litMatcher{
-							pos:        position{line: 407, col: 14, offset: 10327},
+							pos:        position{line: 416, col: 14, offset: 10616},
 							val:        "NE",
 							ignoreCase: false,
 							want:       "\"NE\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 409, col: 5, offset: 10374},
+						pos: position{line: 418, col: 5, offset: 10663},
 						run: (*parser).callonDIRECTION4,
 						expr: &litMatcher{
-							pos:        position{line: 409, col: 5, offset: 10374},
+							pos:        position{line: 418, col: 5, offset: 10663},
 							val:        "SE",
 							ignoreCase: false,
 							want:       "\"SE\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 411, col: 5, offset: 10421},
+						pos: position{line: 420, col: 5, offset: 10710},
 						run: (*parser).callonDIRECTION6,
 						expr: &litMatcher{
-							pos:        position{line: 411, col: 5, offset: 10421},
+							pos:        position{line: 420, col: 5, offset: 10710},
 							val:        "SW",
 							ignoreCase: false,
 							want:       "\"SW\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 413, col: 5, offset: 10468},
+						pos: position{line: 422, col: 5, offset: 10757},
 						run: (*parser).callonDIRECTION8,
 						expr: &litMatcher{
-							pos:        position{line: 413, col: 5, offset: 10468},
+							pos:        position{line: 422, col: 5, offset: 10757},
 							val:        "NW",
 							ignoreCase: false,
 							want:       "\"NW\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 415, col: 5, offset: 10515},
+						pos: position{line: 424, col: 5, offset: 10804},
 						run: (*parser).callonDIRECTION10,
 						expr: &litMatcher{
-							pos:        position{line: 415, col: 5, offset: 10515},
+							pos:        position{line: 424, col: 5, offset: 10804},
 							val:        "N",
 							ignoreCase: false,
 							want:       "\"N\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 417, col: 5, offset: 10557},
+						pos: position{line: 426, col: 5, offset: 10846},
 						run: (*parser).callonDIRECTION12,
 						expr: &litMatcher{
-							pos:        position{line: 417, col: 5, offset: 10557},
+							pos:        position{line: 426, col: 5, offset: 10846},
 							val:        "S",
 							ignoreCase: false,
 							want:       "\"S\"",
@@ -2215,1375 +2216,1375 @@ var g = &grammar{
 		},
 		{
 			name: "ITEM",
-			pos:  position{line: 421, col: 1, offset: 10598},
+			pos:  position{line: 430, col: 1, offset: 10887},
 			expr: &choiceExpr{
-				pos: position{line: 421, col: 9, offset: 10606},
+				pos: position{line: 430, col: 9, offset: 10895},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 421, col: 9, offset: 10606},
+						pos: position{line: 430, col: 9, offset: 10895},
 						run: (*parser).callonITEM2,
 						expr: &litMatcher{
-							pos:        position{line: 421, col: 9, offset: 10606},
+							pos:        position{line: 430, col: 9, offset: 10895},
 							val:        "adze",
 							ignoreCase: true,
 							want:       "\"adze\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 422, col: 6, offset: 10652},
+						pos: position{line: 431, col: 6, offset: 10941},
 						run: (*parser).callonITEM4,
 						expr: &litMatcher{
-							pos:        position{line: 422, col: 6, offset: 10652},
+							pos:        position{line: 431, col: 6, offset: 10941},
 							val:        "arbalest",
 							ignoreCase: true,
 							want:       "\"arbalest\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 423, col: 6, offset: 10702},
+						pos: position{line: 432, col: 6, offset: 10991},
 						run: (*parser).callonITEM6,
 						expr: &litMatcher{
-							pos:        position{line: 423, col: 6, offset: 10702},
+							pos:        position{line: 432, col: 6, offset: 10991},
 							val:        "arrows",
 							ignoreCase: true,
 							want:       "\"arrows\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 424, col: 6, offset: 10750},
+						pos: position{line: 433, col: 6, offset: 11039},
 						run: (*parser).callonITEM8,
 						expr: &litMatcher{
-							pos:        position{line: 424, col: 6, offset: 10750},
+							pos:        position{line: 433, col: 6, offset: 11039},
 							val:        "axes",
 							ignoreCase: true,
 							want:       "\"axes\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 425, col: 6, offset: 10796},
+						pos: position{line: 434, col: 6, offset: 11085},
 						run: (*parser).callonITEM10,
 						expr: &litMatcher{
-							pos:        position{line: 425, col: 6, offset: 10796},
+							pos:        position{line: 434, col: 6, offset: 11085},
 							val:        "backpack",
 							ignoreCase: true,
 							want:       "\"backpack\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 426, col: 6, offset: 10846},
+						pos: position{line: 435, col: 6, offset: 11135},
 						run: (*parser).callonITEM12,
 						expr: &litMatcher{
-							pos:        position{line: 426, col: 6, offset: 10846},
+							pos:        position{line: 435, col: 6, offset: 11135},
 							val:        "ballistae",
 							ignoreCase: true,
 							want:       "\"ballistae\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 427, col: 6, offset: 10897},
+						pos: position{line: 436, col: 6, offset: 11186},
 						run: (*parser).callonITEM14,
 						expr: &litMatcher{
-							pos:        position{line: 427, col: 6, offset: 10897},
+							pos:        position{line: 436, col: 6, offset: 11186},
 							val:        "bark",
 							ignoreCase: true,
 							want:       "\"bark\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 428, col: 6, offset: 10943},
+						pos: position{line: 437, col: 6, offset: 11232},
 						run: (*parser).callonITEM16,
 						expr: &litMatcher{
-							pos:        position{line: 428, col: 6, offset: 10943},
+							pos:        position{line: 437, col: 6, offset: 11232},
 							val:        "barrel",
 							ignoreCase: true,
 							want:       "\"barrel\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 429, col: 6, offset: 10991},
+						pos: position{line: 438, col: 6, offset: 11280},
 						run: (*parser).callonITEM18,
 						expr: &litMatcher{
-							pos:        position{line: 429, col: 6, offset: 10991},
+							pos:        position{line: 438, col: 6, offset: 11280},
 							val:        "bladder",
 							ignoreCase: true,
 							want:       "\"bladder\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 430, col: 6, offset: 11040},
+						pos: position{line: 439, col: 6, offset: 11329},
 						run: (*parser).callonITEM20,
 						expr: &litMatcher{
-							pos:        position{line: 430, col: 6, offset: 11040},
+							pos:        position{line: 439, col: 6, offset: 11329},
 							val:        "blubber",
 							ignoreCase: true,
 							want:       "\"blubber\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 431, col: 6, offset: 11089},
+						pos: position{line: 440, col: 6, offset: 11378},
 						run: (*parser).callonITEM22,
 						expr: &litMatcher{
-							pos:        position{line: 431, col: 6, offset: 11089},
+							pos:        position{line: 440, col: 6, offset: 11378},
 							val:        "boat",
 							ignoreCase: true,
 							want:       "\"boat\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 432, col: 6, offset: 11135},
+						pos: position{line: 441, col: 6, offset: 11424},
 						run: (*parser).callonITEM24,
 						expr: &litMatcher{
-							pos:        position{line: 432, col: 6, offset: 11135},
+							pos:        position{line: 441, col: 6, offset: 11424},
 							val:        "bonearmour",
 							ignoreCase: true,
 							want:       "\"bonearmour\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 433, col: 6, offset: 11187},
+						pos: position{line: 442, col: 6, offset: 11476},
 						run: (*parser).callonITEM26,
 						expr: &litMatcher{
-							pos:        position{line: 433, col: 6, offset: 11187},
+							pos:        position{line: 442, col: 6, offset: 11476},
 							val:        "bones",
 							ignoreCase: true,
 							want:       "\"bones\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 434, col: 6, offset: 11234},
+						pos: position{line: 443, col: 6, offset: 11523},
 						run: (*parser).callonITEM28,
 						expr: &litMatcher{
-							pos:        position{line: 434, col: 6, offset: 11234},
+							pos:        position{line: 443, col: 6, offset: 11523},
 							val:        "bows",
 							ignoreCase: true,
 							want:       "\"bows\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 435, col: 6, offset: 11280},
+						pos: position{line: 444, col: 6, offset: 11569},
 						run: (*parser).callonITEM30,
 						expr: &litMatcher{
-							pos:        position{line: 435, col: 6, offset: 11280},
+							pos:        position{line: 444, col: 6, offset: 11569},
 							val:        "bread",
 							ignoreCase: true,
 							want:       "\"bread\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 436, col: 6, offset: 11327},
+						pos: position{line: 445, col: 6, offset: 11616},
 						run: (*parser).callonITEM32,
 						expr: &litMatcher{
-							pos:        position{line: 436, col: 6, offset: 11327},
+							pos:        position{line: 445, col: 6, offset: 11616},
 							val:        "breastplate",
 							ignoreCase: true,
 							want:       "\"breastplate\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 437, col: 6, offset: 11380},
+						pos: position{line: 446, col: 6, offset: 11669},
 						run: (*parser).callonITEM34,
 						expr: &litMatcher{
-							pos:        position{line: 437, col: 6, offset: 11380},
+							pos:        position{line: 446, col: 6, offset: 11669},
 							val:        "candle",
 							ignoreCase: true,
 							want:       "\"candle\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 438, col: 6, offset: 11428},
+						pos: position{line: 447, col: 6, offset: 11717},
 						run: (*parser).callonITEM36,
 						expr: &litMatcher{
-							pos:        position{line: 438, col: 6, offset: 11428},
+							pos:        position{line: 447, col: 6, offset: 11717},
 							val:        "canoes",
 							ignoreCase: true,
 							want:       "\"canoes\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 439, col: 6, offset: 11476},
+						pos: position{line: 448, col: 6, offset: 11765},
 						run: (*parser).callonITEM38,
 						expr: &litMatcher{
-							pos:        position{line: 439, col: 6, offset: 11476},
+							pos:        position{line: 448, col: 6, offset: 11765},
 							val:        "carpets",
 							ignoreCase: true,
 							want:       "\"carpets\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 440, col: 6, offset: 11525},
+						pos: position{line: 449, col: 6, offset: 11814},
 						run: (*parser).callonITEM40,
 						expr: &litMatcher{
-							pos:        position{line: 440, col: 6, offset: 11525},
+							pos:        position{line: 449, col: 6, offset: 11814},
 							val:        "catapult",
 							ignoreCase: true,
 							want:       "\"catapult\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 441, col: 6, offset: 11575},
+						pos: position{line: 450, col: 6, offset: 11864},
 						run: (*parser).callonITEM42,
 						expr: &litMatcher{
-							pos:        position{line: 441, col: 6, offset: 11575},
+							pos:        position{line: 450, col: 6, offset: 11864},
 							val:        "cattle",
 							ignoreCase: true,
 							want:       "\"cattle\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 442, col: 6, offset: 11623},
+						pos: position{line: 451, col: 6, offset: 11912},
 						run: (*parser).callonITEM44,
 						expr: &litMatcher{
-							pos:        position{line: 442, col: 6, offset: 11623},
+							pos:        position{line: 451, col: 6, offset: 11912},
 							val:        "cauldrons",
 							ignoreCase: true,
 							want:       "\"cauldrons\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 443, col: 6, offset: 11674},
+						pos: position{line: 452, col: 6, offset: 11963},
 						run: (*parser).callonITEM46,
 						expr: &litMatcher{
-							pos:        position{line: 443, col: 6, offset: 11674},
+							pos:        position{line: 452, col: 6, offset: 11963},
 							val:        "chain",
 							ignoreCase: true,
 							want:       "\"chain\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 444, col: 6, offset: 11721},
+						pos: position{line: 453, col: 6, offset: 12010},
 						run: (*parser).callonITEM48,
 						expr: &litMatcher{
-							pos:        position{line: 444, col: 6, offset: 11721},
+							pos:        position{line: 453, col: 6, offset: 12010},
 							val:        "china",
 							ignoreCase: true,
 							want:       "\"china\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 445, col: 6, offset: 11768},
+						pos: position{line: 454, col: 6, offset: 12057},
 						run: (*parser).callonITEM50,
 						expr: &litMatcher{
-							pos:        position{line: 445, col: 6, offset: 11768},
+							pos:        position{line: 454, col: 6, offset: 12057},
 							val:        "clay",
 							ignoreCase: true,
 							want:       "\"clay\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 446, col: 6, offset: 11814},
+						pos: position{line: 455, col: 6, offset: 12103},
 						run: (*parser).callonITEM52,
 						expr: &litMatcher{
-							pos:        position{line: 446, col: 6, offset: 11814},
+							pos:        position{line: 455, col: 6, offset: 12103},
 							val:        "cloth",
 							ignoreCase: true,
 							want:       "\"cloth\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 447, col: 6, offset: 11861},
+						pos: position{line: 456, col: 6, offset: 12150},
 						run: (*parser).callonITEM54,
 						expr: &litMatcher{
-							pos:        position{line: 447, col: 6, offset: 11861},
+							pos:        position{line: 456, col: 6, offset: 12150},
 							val:        "clubs",
 							ignoreCase: true,
 							want:       "\"clubs\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 448, col: 6, offset: 11908},
+						pos: position{line: 457, col: 6, offset: 12197},
 						run: (*parser).callonITEM56,
 						expr: &litMatcher{
-							pos:        position{line: 448, col: 6, offset: 11908},
+							pos:        position{line: 457, col: 6, offset: 12197},
 							val:        "coal",
 							ignoreCase: true,
 							want:       "\"coal\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 449, col: 6, offset: 11954},
+						pos: position{line: 458, col: 6, offset: 12243},
 						run: (*parser).callonITEM58,
 						expr: &litMatcher{
-							pos:        position{line: 449, col: 6, offset: 11954},
+							pos:        position{line: 458, col: 6, offset: 12243},
 							val:        "coffee",
 							ignoreCase: true,
 							want:       "\"coffee\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 450, col: 6, offset: 12002},
+						pos: position{line: 459, col: 6, offset: 12291},
 						run: (*parser).callonITEM60,
 						expr: &litMatcher{
-							pos:        position{line: 450, col: 6, offset: 12002},
+							pos:        position{line: 459, col: 6, offset: 12291},
 							val:        "coins",
 							ignoreCase: true,
 							want:       "\"coins\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 451, col: 6, offset: 12049},
+						pos: position{line: 460, col: 6, offset: 12338},
 						run: (*parser).callonITEM62,
 						expr: &litMatcher{
-							pos:        position{line: 451, col: 6, offset: 12049},
+							pos:        position{line: 460, col: 6, offset: 12338},
 							val:        "cotton",
 							ignoreCase: true,
 							want:       "\"cotton\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 452, col: 6, offset: 12097},
+						pos: position{line: 461, col: 6, offset: 12386},
 						run: (*parser).callonITEM64,
 						expr: &litMatcher{
-							pos:        position{line: 452, col: 6, offset: 12097},
+							pos:        position{line: 461, col: 6, offset: 12386},
 							val:        "cuirass",
 							ignoreCase: true,
 							want:       "\"cuirass\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 453, col: 6, offset: 12146},
+						pos: position{line: 462, col: 6, offset: 12435},
 						run: (*parser).callonITEM66,
 						expr: &litMatcher{
-							pos:        position{line: 453, col: 6, offset: 12146},
+							pos:        position{line: 462, col: 6, offset: 12435},
 							val:        "cuirboilli",
 							ignoreCase: true,
 							want:       "\"cuirboilli\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 454, col: 6, offset: 12198},
+						pos: position{line: 463, col: 6, offset: 12487},
 						run: (*parser).callonITEM68,
 						expr: &litMatcher{
-							pos:        position{line: 454, col: 6, offset: 12198},
+							pos:        position{line: 463, col: 6, offset: 12487},
 							val:        "diamond",
 							ignoreCase: true,
 							want:       "\"diamond\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 455, col: 6, offset: 12247},
+						pos: position{line: 464, col: 6, offset: 12536},
 						run: (*parser).callonITEM70,
 						expr: &litMatcher{
-							pos:        position{line: 455, col: 6, offset: 12247},
+							pos:        position{line: 464, col: 6, offset: 12536},
 							val:        "diamonds",
 							ignoreCase: true,
 							want:       "\"diamonds\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 456, col: 6, offset: 12297},
+						pos: position{line: 465, col: 6, offset: 12586},
 						run: (*parser).callonITEM72,
 						expr: &litMatcher{
-							pos:        position{line: 456, col: 6, offset: 12297},
+							pos:        position{line: 465, col: 6, offset: 12586},
 							val:        "drum",
 							ignoreCase: true,
 							want:       "\"drum\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 457, col: 6, offset: 12343},
+						pos: position{line: 466, col: 6, offset: 12632},
 						run: (*parser).callonITEM74,
 						expr: &litMatcher{
-							pos:        position{line: 457, col: 6, offset: 12343},
+							pos:        position{line: 466, col: 6, offset: 12632},
 							val:        "elephant",
 							ignoreCase: true,
 							want:       "\"elephant\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 458, col: 6, offset: 12393},
+						pos: position{line: 467, col: 6, offset: 12682},
 						run: (*parser).callonITEM76,
 						expr: &litMatcher{
-							pos:        position{line: 458, col: 6, offset: 12393},
+							pos:        position{line: 467, col: 6, offset: 12682},
 							val:        "falchion",
 							ignoreCase: true,
 							want:       "\"falchion\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 459, col: 6, offset: 12443},
+						pos: position{line: 468, col: 6, offset: 12732},
 						run: (*parser).callonITEM78,
 						expr: &litMatcher{
-							pos:        position{line: 459, col: 6, offset: 12443},
+							pos:        position{line: 468, col: 6, offset: 12732},
 							val:        "fish",
 							ignoreCase: true,
 							want:       "\"fish\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 460, col: 6, offset: 12489},
+						pos: position{line: 469, col: 6, offset: 12778},
 						run: (*parser).callonITEM80,
 						expr: &litMatcher{
-							pos:        position{line: 460, col: 6, offset: 12489},
+							pos:        position{line: 469, col: 6, offset: 12778},
 							val:        "flax",
 							ignoreCase: true,
 							want:       "\"flax\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 461, col: 6, offset: 12535},
+						pos: position{line: 470, col: 6, offset: 12824},
 						run: (*parser).callonITEM82,
 						expr: &litMatcher{
-							pos:        position{line: 461, col: 6, offset: 12535},
+							pos:        position{line: 470, col: 6, offset: 12824},
 							val:        "flour",
 							ignoreCase: true,
 							want:       "\"flour\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 462, col: 6, offset: 12582},
+						pos: position{line: 471, col: 6, offset: 12871},
 						run: (*parser).callonITEM84,
 						expr: &litMatcher{
-							pos:        position{line: 462, col: 6, offset: 12582},
+							pos:        position{line: 471, col: 6, offset: 12871},
 							val:        "flute",
 							ignoreCase: true,
 							want:       "\"flute\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 463, col: 6, offset: 12629},
+						pos: position{line: 472, col: 6, offset: 12918},
 						run: (*parser).callonITEM86,
 						expr: &litMatcher{
-							pos:        position{line: 463, col: 6, offset: 12629},
+							pos:        position{line: 472, col: 6, offset: 12918},
 							val:        "fodder",
 							ignoreCase: true,
 							want:       "\"fodder\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 464, col: 6, offset: 12677},
+						pos: position{line: 473, col: 6, offset: 12966},
 						run: (*parser).callonITEM88,
 						expr: &litMatcher{
-							pos:        position{line: 464, col: 6, offset: 12677},
+							pos:        position{line: 473, col: 6, offset: 12966},
 							val:        "frame",
 							ignoreCase: true,
 							want:       "\"frame\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 465, col: 6, offset: 12724},
+						pos: position{line: 474, col: 6, offset: 13013},
 						run: (*parser).callonITEM90,
 						expr: &litMatcher{
-							pos:        position{line: 465, col: 6, offset: 12724},
+							pos:        position{line: 474, col: 6, offset: 13013},
 							val:        "frankincense",
 							ignoreCase: true,
 							want:       "\"frankincense\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 466, col: 6, offset: 12778},
+						pos: position{line: 475, col: 6, offset: 13067},
 						run: (*parser).callonITEM92,
 						expr: &litMatcher{
-							pos:        position{line: 466, col: 6, offset: 12778},
+							pos:        position{line: 475, col: 6, offset: 13067},
 							val:        "fur",
 							ignoreCase: true,
 							want:       "\"fur\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 467, col: 6, offset: 12823},
+						pos: position{line: 476, col: 6, offset: 13112},
 						run: (*parser).callonITEM94,
 						expr: &litMatcher{
-							pos:        position{line: 467, col: 6, offset: 12823},
+							pos:        position{line: 476, col: 6, offset: 13112},
 							val:        "glasspipe",
 							ignoreCase: true,
 							want:       "\"glasspipe\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 468, col: 6, offset: 12874},
+						pos: position{line: 477, col: 6, offset: 13163},
 						run: (*parser).callonITEM96,
 						expr: &litMatcher{
-							pos:        position{line: 468, col: 6, offset: 12874},
+							pos:        position{line: 477, col: 6, offset: 13163},
 							val:        "goats",
 							ignoreCase: true,
 							want:       "\"goats\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 469, col: 6, offset: 12921},
+						pos: position{line: 478, col: 6, offset: 13210},
 						run: (*parser).callonITEM98,
 						expr: &litMatcher{
-							pos:        position{line: 469, col: 6, offset: 12921},
+							pos:        position{line: 478, col: 6, offset: 13210},
 							val:        "gold",
 							ignoreCase: true,
 							want:       "\"gold\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 470, col: 6, offset: 12967},
+						pos: position{line: 479, col: 6, offset: 13256},
 						run: (*parser).callonITEM100,
 						expr: &litMatcher{
-							pos:        position{line: 470, col: 6, offset: 12967},
+							pos:        position{line: 479, col: 6, offset: 13256},
 							val:        "grain",
 							ignoreCase: true,
 							want:       "\"grain\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 471, col: 6, offset: 13014},
+						pos: position{line: 480, col: 6, offset: 13303},
 						run: (*parser).callonITEM102,
 						expr: &litMatcher{
-							pos:        position{line: 471, col: 6, offset: 13014},
+							pos:        position{line: 480, col: 6, offset: 13303},
 							val:        "grape",
 							ignoreCase: true,
 							want:       "\"grape\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 472, col: 6, offset: 13061},
+						pos: position{line: 481, col: 6, offset: 13350},
 						run: (*parser).callonITEM104,
 						expr: &litMatcher{
-							pos:        position{line: 472, col: 6, offset: 13061},
+							pos:        position{line: 481, col: 6, offset: 13350},
 							val:        "gut",
 							ignoreCase: true,
 							want:       "\"gut\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 473, col: 6, offset: 13106},
+						pos: position{line: 482, col: 6, offset: 13395},
 						run: (*parser).callonITEM106,
 						expr: &litMatcher{
-							pos:        position{line: 473, col: 6, offset: 13106},
+							pos:        position{line: 482, col: 6, offset: 13395},
 							val:        "hbow",
 							ignoreCase: true,
 							want:       "\"hbow\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 474, col: 6, offset: 13152},
+						pos: position{line: 483, col: 6, offset: 13441},
 						run: (*parser).callonITEM108,
 						expr: &litMatcher{
-							pos:        position{line: 474, col: 6, offset: 13152},
+							pos:        position{line: 483, col: 6, offset: 13441},
 							val:        "harp",
 							ignoreCase: true,
 							want:       "\"harp\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 475, col: 6, offset: 13198},
+						pos: position{line: 484, col: 6, offset: 13487},
 						run: (*parser).callonITEM110,
 						expr: &litMatcher{
-							pos:        position{line: 475, col: 6, offset: 13198},
+							pos:        position{line: 484, col: 6, offset: 13487},
 							val:        "haube",
 							ignoreCase: true,
 							want:       "\"haube\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 476, col: 6, offset: 13245},
+						pos: position{line: 485, col: 6, offset: 13534},
 						run: (*parser).callonITEM112,
 						expr: &litMatcher{
-							pos:        position{line: 476, col: 6, offset: 13245},
+							pos:        position{line: 485, col: 6, offset: 13534},
 							val:        "heaters",
 							ignoreCase: true,
 							want:       "\"heaters\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 477, col: 6, offset: 13294},
+						pos: position{line: 486, col: 6, offset: 13583},
 						run: (*parser).callonITEM114,
 						expr: &litMatcher{
-							pos:        position{line: 477, col: 6, offset: 13294},
+							pos:        position{line: 486, col: 6, offset: 13583},
 							val:        "helm",
 							ignoreCase: true,
 							want:       "\"helm\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 478, col: 6, offset: 13340},
+						pos: position{line: 487, col: 6, offset: 13629},
 						run: (*parser).callonITEM116,
 						expr: &litMatcher{
-							pos:        position{line: 478, col: 6, offset: 13340},
+							pos:        position{line: 487, col: 6, offset: 13629},
 							val:        "herbs",
 							ignoreCase: true,
 							want:       "\"herbs\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 479, col: 6, offset: 13387},
+						pos: position{line: 488, col: 6, offset: 13676},
 						run: (*parser).callonITEM118,
 						expr: &litMatcher{
-							pos:        position{line: 479, col: 6, offset: 13387},
+							pos:        position{line: 488, col: 6, offset: 13676},
 							val:        "hive",
 							ignoreCase: true,
 							want:       "\"hive\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 480, col: 6, offset: 13433},
+						pos: position{line: 489, col: 6, offset: 13722},
 						run: (*parser).callonITEM120,
 						expr: &litMatcher{
-							pos:        position{line: 480, col: 6, offset: 13433},
+							pos:        position{line: 489, col: 6, offset: 13722},
 							val:        "hoe",
 							ignoreCase: true,
 							want:       "\"hoe\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 481, col: 6, offset: 13478},
+						pos: position{line: 490, col: 6, offset: 13767},
 						run: (*parser).callonITEM122,
 						expr: &litMatcher{
-							pos:        position{line: 481, col: 6, offset: 13478},
+							pos:        position{line: 490, col: 6, offset: 13767},
 							val:        "honey",
 							ignoreCase: true,
 							want:       "\"honey\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 482, col: 6, offset: 13525},
+						pos: position{line: 491, col: 6, offset: 13814},
 						run: (*parser).callonITEM124,
 						expr: &litMatcher{
-							pos:        position{line: 482, col: 6, offset: 13525},
+							pos:        position{line: 491, col: 6, offset: 13814},
 							val:        "hood",
 							ignoreCase: true,
 							want:       "\"hood\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 483, col: 6, offset: 13571},
+						pos: position{line: 492, col: 6, offset: 13860},
 						run: (*parser).callonITEM126,
 						expr: &litMatcher{
-							pos:        position{line: 483, col: 6, offset: 13571},
+							pos:        position{line: 492, col: 6, offset: 13860},
 							val:        "horn",
 							ignoreCase: true,
 							want:       "\"horn\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 484, col: 6, offset: 13617},
+						pos: position{line: 493, col: 6, offset: 13906},
 						run: (*parser).callonITEM128,
 						expr: &litMatcher{
-							pos:        position{line: 484, col: 6, offset: 13617},
+							pos:        position{line: 493, col: 6, offset: 13906},
 							val:        "horses",
 							ignoreCase: true,
 							want:       "\"horses\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 485, col: 6, offset: 13665},
+						pos: position{line: 494, col: 6, offset: 13954},
 						run: (*parser).callonITEM130,
 						expr: &litMatcher{
-							pos:        position{line: 485, col: 6, offset: 13665},
+							pos:        position{line: 494, col: 6, offset: 13954},
 							val:        "jade",
 							ignoreCase: true,
 							want:       "\"jade\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 486, col: 6, offset: 13711},
+						pos: position{line: 495, col: 6, offset: 14000},
 						run: (*parser).callonITEM132,
 						expr: &litMatcher{
-							pos:        position{line: 486, col: 6, offset: 13711},
+							pos:        position{line: 495, col: 6, offset: 14000},
 							val:        "jerkin",
 							ignoreCase: true,
 							want:       "\"jerkin\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 487, col: 6, offset: 13759},
+						pos: position{line: 496, col: 6, offset: 14048},
 						run: (*parser).callonITEM134,
 						expr: &litMatcher{
-							pos:        position{line: 487, col: 6, offset: 13759},
+							pos:        position{line: 496, col: 6, offset: 14048},
 							val:        "kayak",
 							ignoreCase: true,
 							want:       "\"kayak\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 488, col: 6, offset: 13806},
+						pos: position{line: 497, col: 6, offset: 14095},
 						run: (*parser).callonITEM136,
 						expr: &litMatcher{
-							pos:        position{line: 488, col: 6, offset: 13806},
+							pos:        position{line: 497, col: 6, offset: 14095},
 							val:        "ladder",
 							ignoreCase: true,
 							want:       "\"ladder\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 489, col: 6, offset: 13854},
+						pos: position{line: 498, col: 6, offset: 14143},
 						run: (*parser).callonITEM138,
 						expr: &litMatcher{
-							pos:        position{line: 489, col: 6, offset: 13854},
+							pos:        position{line: 498, col: 6, offset: 14143},
 							val:        "leather",
 							ignoreCase: true,
 							want:       "\"leather\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 490, col: 6, offset: 13903},
+						pos: position{line: 499, col: 6, offset: 14192},
 						run: (*parser).callonITEM140,
 						expr: &litMatcher{
-							pos:        position{line: 490, col: 6, offset: 13903},
+							pos:        position{line: 499, col: 6, offset: 14192},
 							val:        "logs",
 							ignoreCase: true,
 							want:       "\"logs\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 491, col: 6, offset: 13949},
+						pos: position{line: 500, col: 6, offset: 14238},
 						run: (*parser).callonITEM142,
 						expr: &litMatcher{
-							pos:        position{line: 491, col: 6, offset: 13949},
+							pos:        position{line: 500, col: 6, offset: 14238},
 							val:        "lute",
 							ignoreCase: true,
 							want:       "\"lute\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 492, col: 6, offset: 13995},
+						pos: position{line: 501, col: 6, offset: 14284},
 						run: (*parser).callonITEM144,
 						expr: &litMatcher{
-							pos:        position{line: 492, col: 6, offset: 13995},
+							pos:        position{line: 501, col: 6, offset: 14284},
 							val:        "mace",
 							ignoreCase: true,
 							want:       "\"mace\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 493, col: 6, offset: 14041},
+						pos: position{line: 502, col: 6, offset: 14330},
 						run: (*parser).callonITEM146,
 						expr: &litMatcher{
-							pos:        position{line: 493, col: 6, offset: 14041},
+							pos:        position{line: 502, col: 6, offset: 14330},
 							val:        "mattock",
 							ignoreCase: true,
 							want:       "\"mattock\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 494, col: 6, offset: 14090},
+						pos: position{line: 503, col: 6, offset: 14379},
 						run: (*parser).callonITEM148,
 						expr: &litMatcher{
-							pos:        position{line: 494, col: 6, offset: 14090},
+							pos:        position{line: 503, col: 6, offset: 14379},
 							val:        "metal",
 							ignoreCase: true,
 							want:       "\"metal\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 495, col: 6, offset: 14137},
+						pos: position{line: 504, col: 6, offset: 14426},
 						run: (*parser).callonITEM150,
 						expr: &litMatcher{
-							pos:        position{line: 495, col: 6, offset: 14137},
+							pos:        position{line: 504, col: 6, offset: 14426},
 							val:        "millstone",
 							ignoreCase: true,
 							want:       "\"millstone\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 496, col: 6, offset: 14188},
+						pos: position{line: 505, col: 6, offset: 14477},
 						run: (*parser).callonITEM152,
 						expr: &litMatcher{
-							pos:        position{line: 496, col: 6, offset: 14188},
+							pos:        position{line: 505, col: 6, offset: 14477},
 							val:        "musk",
 							ignoreCase: true,
 							want:       "\"musk\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 497, col: 6, offset: 14234},
+						pos: position{line: 506, col: 6, offset: 14523},
 						run: (*parser).callonITEM154,
 						expr: &litMatcher{
-							pos:        position{line: 497, col: 6, offset: 14234},
+							pos:        position{line: 506, col: 6, offset: 14523},
 							val:        "net",
 							ignoreCase: true,
 							want:       "\"net\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 498, col: 6, offset: 14279},
+						pos: position{line: 507, col: 6, offset: 14568},
 						run: (*parser).callonITEM156,
 						expr: &litMatcher{
-							pos:        position{line: 498, col: 6, offset: 14279},
+							pos:        position{line: 507, col: 6, offset: 14568},
 							val:        "oar",
 							ignoreCase: true,
 							want:       "\"oar\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 499, col: 6, offset: 14324},
+						pos: position{line: 508, col: 6, offset: 14613},
 						run: (*parser).callonITEM158,
 						expr: &litMatcher{
-							pos:        position{line: 499, col: 6, offset: 14324},
+							pos:        position{line: 508, col: 6, offset: 14613},
 							val:        "oil",
 							ignoreCase: true,
 							want:       "\"oil\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 500, col: 6, offset: 14369},
+						pos: position{line: 509, col: 6, offset: 14658},
 						run: (*parser).callonITEM160,
 						expr: &litMatcher{
-							pos:        position{line: 500, col: 6, offset: 14369},
+							pos:        position{line: 509, col: 6, offset: 14658},
 							val:        "olives",
 							ignoreCase: true,
 							want:       "\"olives\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 501, col: 6, offset: 14417},
+						pos: position{line: 510, col: 6, offset: 14706},
 						run: (*parser).callonITEM162,
 						expr: &litMatcher{
-							pos:        position{line: 501, col: 6, offset: 14417},
+							pos:        position{line: 510, col: 6, offset: 14706},
 							val:        "opium",
 							ignoreCase: true,
 							want:       "\"opium\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 502, col: 6, offset: 14464},
+						pos: position{line: 511, col: 6, offset: 14753},
 						run: (*parser).callonITEM164,
 						expr: &litMatcher{
-							pos:        position{line: 502, col: 6, offset: 14464},
+							pos:        position{line: 511, col: 6, offset: 14753},
 							val:        "ores",
 							ignoreCase: true,
 							want:       "\"ores\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 503, col: 6, offset: 14510},
+						pos: position{line: 512, col: 6, offset: 14799},
 						run: (*parser).callonITEM166,
 						expr: &litMatcher{
-							pos:        position{line: 503, col: 6, offset: 14510},
+							pos:        position{line: 512, col: 6, offset: 14799},
 							val:        "paddle",
 							ignoreCase: true,
 							want:       "\"paddle\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 504, col: 6, offset: 14558},
+						pos: position{line: 513, col: 6, offset: 14847},
 						run: (*parser).callonITEM168,
 						expr: &litMatcher{
-							pos:        position{line: 504, col: 6, offset: 14558},
+							pos:        position{line: 513, col: 6, offset: 14847},
 							val:        "palanquin",
 							ignoreCase: true,
 							want:       "\"palanquin\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 505, col: 6, offset: 14609},
+						pos: position{line: 514, col: 6, offset: 14898},
 						run: (*parser).callonITEM170,
 						expr: &litMatcher{
-							pos:        position{line: 505, col: 6, offset: 14609},
+							pos:        position{line: 514, col: 6, offset: 14898},
 							val:        "parchment",
 							ignoreCase: true,
 							want:       "\"parchment\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 506, col: 6, offset: 14660},
+						pos: position{line: 515, col: 6, offset: 14949},
 						run: (*parser).callonITEM172,
 						expr: &litMatcher{
-							pos:        position{line: 506, col: 6, offset: 14660},
+							pos:        position{line: 515, col: 6, offset: 14949},
 							val:        "pavis",
 							ignoreCase: true,
 							want:       "\"pavis\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 507, col: 6, offset: 14707},
+						pos: position{line: 516, col: 6, offset: 14996},
 						run: (*parser).callonITEM174,
 						expr: &litMatcher{
-							pos:        position{line: 507, col: 6, offset: 14707},
+							pos:        position{line: 516, col: 6, offset: 14996},
 							val:        "pearls",
 							ignoreCase: true,
 							want:       "\"pearls\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 508, col: 6, offset: 14755},
+						pos: position{line: 517, col: 6, offset: 15044},
 						run: (*parser).callonITEM176,
 						expr: &litMatcher{
-							pos:        position{line: 508, col: 6, offset: 14755},
+							pos:        position{line: 517, col: 6, offset: 15044},
 							val:        "pellets",
 							ignoreCase: true,
 							want:       "\"pellets\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 509, col: 6, offset: 14804},
+						pos: position{line: 518, col: 6, offset: 15093},
 						run: (*parser).callonITEM178,
 						expr: &litMatcher{
-							pos:        position{line: 509, col: 6, offset: 14804},
+							pos:        position{line: 518, col: 6, offset: 15093},
 							val:        "people",
 							ignoreCase: true,
 							want:       "\"people\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 510, col: 6, offset: 14852},
+						pos: position{line: 519, col: 6, offset: 15141},
 						run: (*parser).callonITEM180,
 						expr: &litMatcher{
-							pos:        position{line: 510, col: 6, offset: 14852},
+							pos:        position{line: 519, col: 6, offset: 15141},
 							val:        "pewter",
 							ignoreCase: true,
 							want:       "\"pewter\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 511, col: 6, offset: 14900},
+						pos: position{line: 520, col: 6, offset: 15189},
 						run: (*parser).callonITEM182,
 						expr: &litMatcher{
-							pos:        position{line: 511, col: 6, offset: 14900},
+							pos:        position{line: 520, col: 6, offset: 15189},
 							val:        "picks",
 							ignoreCase: true,
 							want:       "\"picks\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 512, col: 6, offset: 14947},
+						pos: position{line: 521, col: 6, offset: 15236},
 						run: (*parser).callonITEM184,
 						expr: &litMatcher{
-							pos:        position{line: 512, col: 6, offset: 14947},
+							pos:        position{line: 521, col: 6, offset: 15236},
 							val:        "plows",
 							ignoreCase: true,
 							want:       "\"plows\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 513, col: 6, offset: 14994},
+						pos: position{line: 522, col: 6, offset: 15283},
 						run: (*parser).callonITEM186,
 						expr: &litMatcher{
-							pos:        position{line: 513, col: 6, offset: 14994},
+							pos:        position{line: 522, col: 6, offset: 15283},
 							val:        "provisions",
 							ignoreCase: true,
 							want:       "\"provisions\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 514, col: 6, offset: 15046},
+						pos: position{line: 523, col: 6, offset: 15335},
 						run: (*parser).callonITEM188,
 						expr: &litMatcher{
-							pos:        position{line: 514, col: 6, offset: 15046},
+							pos:        position{line: 523, col: 6, offset: 15335},
 							val:        "quarrel",
 							ignoreCase: true,
 							want:       "\"quarrel\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 515, col: 6, offset: 15095},
+						pos: position{line: 524, col: 6, offset: 15384},
 						run: (*parser).callonITEM190,
 						expr: &litMatcher{
-							pos:        position{line: 515, col: 6, offset: 15095},
+							pos:        position{line: 524, col: 6, offset: 15384},
 							val:        "rake",
 							ignoreCase: true,
 							want:       "\"rake\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 516, col: 6, offset: 15141},
+						pos: position{line: 525, col: 6, offset: 15430},
 						run: (*parser).callonITEM192,
 						expr: &litMatcher{
-							pos:        position{line: 516, col: 6, offset: 15141},
+							pos:        position{line: 525, col: 6, offset: 15430},
 							val:        "ram",
 							ignoreCase: true,
 							want:       "\"ram\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 517, col: 6, offset: 15186},
+						pos: position{line: 526, col: 6, offset: 15475},
 						run: (*parser).callonITEM194,
 						expr: &litMatcher{
-							pos:        position{line: 517, col: 6, offset: 15186},
+							pos:        position{line: 526, col: 6, offset: 15475},
 							val:        "ramp",
 							ignoreCase: true,
 							want:       "\"ramp\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 518, col: 6, offset: 15232},
+						pos: position{line: 527, col: 6, offset: 15521},
 						run: (*parser).callonITEM196,
 						expr: &litMatcher{
-							pos:        position{line: 518, col: 6, offset: 15232},
+							pos:        position{line: 527, col: 6, offset: 15521},
 							val:        "ring",
 							ignoreCase: true,
 							want:       "\"ring\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 519, col: 6, offset: 15278},
+						pos: position{line: 528, col: 6, offset: 15567},
 						run: (*parser).callonITEM198,
 						expr: &litMatcher{
-							pos:        position{line: 519, col: 6, offset: 15278},
+							pos:        position{line: 528, col: 6, offset: 15567},
 							val:        "rope",
 							ignoreCase: true,
 							want:       "\"rope\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 520, col: 6, offset: 15324},
+						pos: position{line: 529, col: 6, offset: 15613},
 						run: (*parser).callonITEM200,
 						expr: &litMatcher{
-							pos:        position{line: 520, col: 6, offset: 15324},
+							pos:        position{line: 529, col: 6, offset: 15613},
 							val:        "rug",
 							ignoreCase: true,
 							want:       "\"rug\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 521, col: 6, offset: 15369},
+						pos: position{line: 530, col: 6, offset: 15658},
 						run: (*parser).callonITEM202,
 						expr: &litMatcher{
-							pos:        position{line: 521, col: 6, offset: 15369},
+							pos:        position{line: 530, col: 6, offset: 15658},
 							val:        "saddle",
 							ignoreCase: true,
 							want:       "\"saddle\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 522, col: 6, offset: 15417},
+						pos: position{line: 531, col: 6, offset: 15706},
 						run: (*parser).callonITEM204,
 						expr: &litMatcher{
-							pos:        position{line: 522, col: 6, offset: 15417},
+							pos:        position{line: 531, col: 6, offset: 15706},
 							val:        "saddlebag",
 							ignoreCase: true,
 							want:       "\"saddlebag\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 523, col: 6, offset: 15468},
+						pos: position{line: 532, col: 6, offset: 15757},
 						run: (*parser).callonITEM206,
 						expr: &litMatcher{
-							pos:        position{line: 523, col: 6, offset: 15468},
+							pos:        position{line: 532, col: 6, offset: 15757},
 							val:        "salt",
 							ignoreCase: true,
 							want:       "\"salt\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 524, col: 6, offset: 15514},
+						pos: position{line: 533, col: 6, offset: 15803},
 						run: (*parser).callonITEM208,
 						expr: &litMatcher{
-							pos:        position{line: 524, col: 6, offset: 15514},
+							pos:        position{line: 533, col: 6, offset: 15803},
 							val:        "sand",
 							ignoreCase: true,
 							want:       "\"sand\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 525, col: 6, offset: 15560},
+						pos: position{line: 534, col: 6, offset: 15849},
 						run: (*parser).callonITEM210,
 						expr: &litMatcher{
-							pos:        position{line: 525, col: 6, offset: 15560},
+							pos:        position{line: 534, col: 6, offset: 15849},
 							val:        "scale",
 							ignoreCase: true,
 							want:       "\"scale\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 526, col: 6, offset: 15607},
+						pos: position{line: 535, col: 6, offset: 15896},
 						run: (*parser).callonITEM212,
 						expr: &litMatcher{
-							pos:        position{line: 526, col: 6, offset: 15607},
+							pos:        position{line: 535, col: 6, offset: 15896},
 							val:        "sculpture",
 							ignoreCase: true,
 							want:       "\"sculpture\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 527, col: 6, offset: 15658},
+						pos: position{line: 536, col: 6, offset: 15947},
 						run: (*parser).callonITEM214,
 						expr: &litMatcher{
-							pos:        position{line: 527, col: 6, offset: 15658},
+							pos:        position{line: 536, col: 6, offset: 15947},
 							val:        "scutum",
 							ignoreCase: true,
 							want:       "\"scutum\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 528, col: 6, offset: 15706},
+						pos: position{line: 537, col: 6, offset: 15995},
 						run: (*parser).callonITEM216,
 						expr: &litMatcher{
-							pos:        position{line: 528, col: 6, offset: 15706},
+							pos:        position{line: 537, col: 6, offset: 15995},
 							val:        "scythe",
 							ignoreCase: true,
 							want:       "\"scythe\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 529, col: 6, offset: 15754},
+						pos: position{line: 538, col: 6, offset: 16043},
 						run: (*parser).callonITEM218,
 						expr: &litMatcher{
-							pos:        position{line: 529, col: 6, offset: 15754},
+							pos:        position{line: 538, col: 6, offset: 16043},
 							val:        "shackle",
 							ignoreCase: true,
 							want:       "\"shackle\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 530, col: 6, offset: 15803},
+						pos: position{line: 539, col: 6, offset: 16092},
 						run: (*parser).callonITEM220,
 						expr: &litMatcher{
-							pos:        position{line: 530, col: 6, offset: 15803},
+							pos:        position{line: 539, col: 6, offset: 16092},
 							val:        "shaft",
 							ignoreCase: true,
 							want:       "\"shaft\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 531, col: 6, offset: 15850},
+						pos: position{line: 540, col: 6, offset: 16139},
 						run: (*parser).callonITEM222,
 						expr: &litMatcher{
-							pos:        position{line: 531, col: 6, offset: 15850},
+							pos:        position{line: 540, col: 6, offset: 16139},
 							val:        "shield",
 							ignoreCase: true,
 							want:       "\"shield\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 532, col: 6, offset: 15898},
+						pos: position{line: 541, col: 6, offset: 16187},
 						run: (*parser).callonITEM224,
 						expr: &litMatcher{
-							pos:        position{line: 532, col: 6, offset: 15898},
+							pos:        position{line: 541, col: 6, offset: 16187},
 							val:        "shovel",
 							ignoreCase: true,
 							want:       "\"shovel\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 533, col: 6, offset: 15946},
+						pos: position{line: 542, col: 6, offset: 16235},
 						run: (*parser).callonITEM226,
 						expr: &litMatcher{
-							pos:        position{line: 533, col: 6, offset: 15946},
+							pos:        position{line: 542, col: 6, offset: 16235},
 							val:        "silk",
 							ignoreCase: true,
 							want:       "\"silk\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 534, col: 6, offset: 15992},
+						pos: position{line: 543, col: 6, offset: 16281},
 						run: (*parser).callonITEM228,
 						expr: &litMatcher{
-							pos:        position{line: 534, col: 6, offset: 15992},
+							pos:        position{line: 543, col: 6, offset: 16281},
 							val:        "silver",
 							ignoreCase: true,
 							want:       "\"silver\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 535, col: 6, offset: 16040},
+						pos: position{line: 544, col: 6, offset: 16329},
 						run: (*parser).callonITEM230,
 						expr: &litMatcher{
-							pos:        position{line: 535, col: 6, offset: 16040},
+							pos:        position{line: 544, col: 6, offset: 16329},
 							val:        "skin",
 							ignoreCase: true,
 							want:       "\"skin\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 536, col: 6, offset: 16086},
+						pos: position{line: 545, col: 6, offset: 16375},
 						run: (*parser).callonITEM232,
 						expr: &litMatcher{
-							pos:        position{line: 536, col: 6, offset: 16086},
+							pos:        position{line: 545, col: 6, offset: 16375},
 							val:        "slaves",
 							ignoreCase: true,
 							want:       "\"slaves\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 537, col: 6, offset: 16134},
+						pos: position{line: 546, col: 6, offset: 16423},
 						run: (*parser).callonITEM234,
 						expr: &litMatcher{
-							pos:        position{line: 537, col: 6, offset: 16134},
+							pos:        position{line: 546, col: 6, offset: 16423},
 							val:        "slings",
 							ignoreCase: true,
 							want:       "\"slings\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 538, col: 6, offset: 16182},
+						pos: position{line: 547, col: 6, offset: 16471},
 						run: (*parser).callonITEM236,
 						expr: &litMatcher{
-							pos:        position{line: 538, col: 6, offset: 16182},
+							pos:        position{line: 547, col: 6, offset: 16471},
 							val:        "snare",
 							ignoreCase: true,
 							want:       "\"snare\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 539, col: 6, offset: 16229},
+						pos: position{line: 548, col: 6, offset: 16518},
 						run: (*parser).callonITEM238,
 						expr: &litMatcher{
-							pos:        position{line: 539, col: 6, offset: 16229},
+							pos:        position{line: 548, col: 6, offset: 16518},
 							val:        "spear",
 							ignoreCase: true,
 							want:       "\"spear\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 540, col: 6, offset: 16276},
+						pos: position{line: 549, col: 6, offset: 16565},
 						run: (*parser).callonITEM240,
 						expr: &litMatcher{
-							pos:        position{line: 540, col: 6, offset: 16276},
+							pos:        position{line: 549, col: 6, offset: 16565},
 							val:        "spetum",
 							ignoreCase: true,
 							want:       "\"spetum\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 541, col: 6, offset: 16324},
+						pos: position{line: 550, col: 6, offset: 16613},
 						run: (*parser).callonITEM242,
 						expr: &litMatcher{
-							pos:        position{line: 541, col: 6, offset: 16324},
+							pos:        position{line: 550, col: 6, offset: 16613},
 							val:        "spice",
 							ignoreCase: true,
 							want:       "\"spice\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 542, col: 6, offset: 16371},
+						pos: position{line: 551, col: 6, offset: 16660},
 						run: (*parser).callonITEM244,
 						expr: &litMatcher{
-							pos:        position{line: 542, col: 6, offset: 16371},
+							pos:        position{line: 551, col: 6, offset: 16660},
 							val:        "statue",
 							ignoreCase: true,
 							want:       "\"statue\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 543, col: 6, offset: 16419},
+						pos: position{line: 552, col: 6, offset: 16708},
 						run: (*parser).callonITEM246,
 						expr: &litMatcher{
-							pos:        position{line: 543, col: 6, offset: 16419},
+							pos:        position{line: 552, col: 6, offset: 16708},
 							val:        "stave",
 							ignoreCase: true,
 							want:       "\"stave\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 544, col: 6, offset: 16466},
+						pos: position{line: 553, col: 6, offset: 16755},
 						run: (*parser).callonITEM248,
 						expr: &litMatcher{
-							pos:        position{line: 544, col: 6, offset: 16466},
+							pos:        position{line: 553, col: 6, offset: 16755},
 							val:        "stones",
 							ignoreCase: true,
 							want:       "\"stones\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 545, col: 6, offset: 16514},
+						pos: position{line: 554, col: 6, offset: 16803},
 						run: (*parser).callonITEM250,
 						expr: &litMatcher{
-							pos:        position{line: 545, col: 6, offset: 16514},
+							pos:        position{line: 554, col: 6, offset: 16803},
 							val:        "string",
 							ignoreCase: true,
 							want:       "\"string\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 546, col: 6, offset: 16562},
+						pos: position{line: 555, col: 6, offset: 16851},
 						run: (*parser).callonITEM252,
 						expr: &litMatcher{
-							pos:        position{line: 546, col: 6, offset: 16562},
+							pos:        position{line: 555, col: 6, offset: 16851},
 							val:        "sugar",
 							ignoreCase: true,
 							want:       "\"sugar\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 547, col: 6, offset: 16609},
+						pos: position{line: 556, col: 6, offset: 16898},
 						run: (*parser).callonITEM254,
 						expr: &litMatcher{
-							pos:        position{line: 547, col: 6, offset: 16609},
+							pos:        position{line: 556, col: 6, offset: 16898},
 							val:        "sword",
 							ignoreCase: true,
 							want:       "\"sword\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 548, col: 6, offset: 16656},
+						pos: position{line: 557, col: 6, offset: 16945},
 						run: (*parser).callonITEM256,
 						expr: &litMatcher{
-							pos:        position{line: 548, col: 6, offset: 16656},
+							pos:        position{line: 557, col: 6, offset: 16945},
 							val:        "tapestries",
 							ignoreCase: true,
 							want:       "\"tapestries\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 549, col: 6, offset: 16708},
+						pos: position{line: 558, col: 6, offset: 16997},
 						run: (*parser).callonITEM258,
 						expr: &litMatcher{
-							pos:        position{line: 549, col: 6, offset: 16708},
+							pos:        position{line: 558, col: 6, offset: 16997},
 							val:        "tea",
 							ignoreCase: true,
 							want:       "\"tea\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 550, col: 6, offset: 16753},
+						pos: position{line: 559, col: 6, offset: 17042},
 						run: (*parser).callonITEM260,
 						expr: &litMatcher{
-							pos:        position{line: 550, col: 6, offset: 16753},
+							pos:        position{line: 559, col: 6, offset: 17042},
 							val:        "tobacco",
 							ignoreCase: true,
 							want:       "\"tobacco\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 551, col: 6, offset: 16802},
+						pos: position{line: 560, col: 6, offset: 17091},
 						run: (*parser).callonITEM262,
 						expr: &litMatcher{
-							pos:        position{line: 551, col: 6, offset: 16802},
+							pos:        position{line: 560, col: 6, offset: 17091},
 							val:        "trap",
 							ignoreCase: true,
 							want:       "\"trap\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 552, col: 6, offset: 16848},
+						pos: position{line: 561, col: 6, offset: 17137},
 						run: (*parser).callonITEM264,
 						expr: &litMatcher{
-							pos:        position{line: 552, col: 6, offset: 16848},
+							pos:        position{line: 561, col: 6, offset: 17137},
 							val:        "trews",
 							ignoreCase: true,
 							want:       "\"trews\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 553, col: 6, offset: 16895},
+						pos: position{line: 562, col: 6, offset: 17184},
 						run: (*parser).callonITEM266,
 						expr: &litMatcher{
-							pos:        position{line: 553, col: 6, offset: 16895},
+							pos:        position{line: 562, col: 6, offset: 17184},
 							val:        "trinket",
 							ignoreCase: true,
 							want:       "\"trinket\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 554, col: 6, offset: 16944},
+						pos: position{line: 563, col: 6, offset: 17233},
 						run: (*parser).callonITEM268,
 						expr: &litMatcher{
-							pos:        position{line: 554, col: 6, offset: 16944},
+							pos:        position{line: 563, col: 6, offset: 17233},
 							val:        "trumpet",
 							ignoreCase: true,
 							want:       "\"trumpet\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 555, col: 6, offset: 16993},
+						pos: position{line: 564, col: 6, offset: 17282},
 						run: (*parser).callonITEM270,
 						expr: &litMatcher{
-							pos:        position{line: 555, col: 6, offset: 16993},
+							pos:        position{line: 564, col: 6, offset: 17282},
 							val:        "urn",
 							ignoreCase: true,
 							want:       "\"urn\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 556, col: 6, offset: 17038},
+						pos: position{line: 565, col: 6, offset: 17327},
 						run: (*parser).callonITEM272,
 						expr: &litMatcher{
-							pos:        position{line: 556, col: 6, offset: 17038},
+							pos:        position{line: 565, col: 6, offset: 17327},
 							val:        "wagons",
 							ignoreCase: true,
 							want:       "\"wagons\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 557, col: 6, offset: 17086},
+						pos: position{line: 566, col: 6, offset: 17375},
 						run: (*parser).callonITEM274,
 						expr: &litMatcher{
-							pos:        position{line: 557, col: 6, offset: 17086},
+							pos:        position{line: 566, col: 6, offset: 17375},
 							val:        "wax",
 							ignoreCase: true,
 							want:       "\"wax\"i",
@@ -3594,21 +3595,21 @@ var g = &grammar{
 		},
 		{
 			name: "MONTH",
-			pos:  position{line: 559, col: 1, offset: 17128},
+			pos:  position{line: 568, col: 1, offset: 17417},
 			expr: &actionExpr{
-				pos: position{line: 559, col: 10, offset: 17137},
+				pos: position{line: 568, col: 10, offset: 17426},
 				run: (*parser).callonMONTH1,
 				expr: &seqExpr{
-					pos: position{line: 559, col: 10, offset: 17137},
+					pos: position{line: 568, col: 10, offset: 17426},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 559, col: 10, offset: 17137},
+							pos:  position{line: 568, col: 10, offset: 17426},
 							name: "DIGIT",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 559, col: 16, offset: 17143},
+							pos: position{line: 568, col: 16, offset: 17432},
 							expr: &ruleRefExpr{
-								pos:  position{line: 559, col: 16, offset: 17143},
+								pos:  position{line: 568, col: 16, offset: 17432},
 								name: "DIGIT",
 							},
 						},
@@ -3618,14 +3619,14 @@ var g = &grammar{
 		},
 		{
 			name: "NUMBER",
-			pos:  position{line: 564, col: 1, offset: 17219},
+			pos:  position{line: 573, col: 1, offset: 17508},
 			expr: &actionExpr{
-				pos: position{line: 564, col: 11, offset: 17229},
+				pos: position{line: 573, col: 11, offset: 17518},
 				run: (*parser).callonNUMBER1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 564, col: 11, offset: 17229},
+					pos: position{line: 573, col: 11, offset: 17518},
 					expr: &charClassMatcher{
-						pos:        position{line: 564, col: 11, offset: 17229},
+						pos:        position{line: 573, col: 11, offset: 17518},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -3636,205 +3637,205 @@ var g = &grammar{
 		},
 		{
 			name: "RESOURCE",
-			pos:  position{line: 569, col: 1, offset: 17305},
+			pos:  position{line: 578, col: 1, offset: 17594},
 			expr: &choiceExpr{
-				pos: position{line: 569, col: 13, offset: 17317},
+				pos: position{line: 578, col: 13, offset: 17606},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 569, col: 13, offset: 17317},
+						pos: position{line: 578, col: 13, offset: 17606},
 						run: (*parser).callonRESOURCE2,
 						expr: &litMatcher{
-							pos:        position{line: 569, col: 13, offset: 17317},
+							pos:        position{line: 578, col: 13, offset: 17606},
 							val:        "coal",
 							ignoreCase: true,
 							want:       "\"Coal\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 571, col: 5, offset: 17362},
+						pos: position{line: 580, col: 5, offset: 17651},
 						run: (*parser).callonRESOURCE4,
 						expr: &litMatcher{
-							pos:        position{line: 571, col: 5, offset: 17362},
+							pos:        position{line: 580, col: 5, offset: 17651},
 							val:        "copper ore",
 							ignoreCase: true,
 							want:       "\"Copper Ore\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 573, col: 5, offset: 17418},
+						pos: position{line: 582, col: 5, offset: 17707},
 						run: (*parser).callonRESOURCE6,
 						expr: &litMatcher{
-							pos:        position{line: 573, col: 5, offset: 17418},
+							pos:        position{line: 582, col: 5, offset: 17707},
 							val:        "diamond",
 							ignoreCase: true,
 							want:       "\"Diamond\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 575, col: 5, offset: 17469},
+						pos: position{line: 584, col: 5, offset: 17758},
 						run: (*parser).callonRESOURCE8,
 						expr: &litMatcher{
-							pos:        position{line: 575, col: 5, offset: 17469},
+							pos:        position{line: 584, col: 5, offset: 17758},
 							val:        "frankincense",
 							ignoreCase: true,
 							want:       "\"Frankincense\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 577, col: 5, offset: 17530},
+						pos: position{line: 586, col: 5, offset: 17819},
 						run: (*parser).callonRESOURCE10,
 						expr: &litMatcher{
-							pos:        position{line: 577, col: 5, offset: 17530},
+							pos:        position{line: 586, col: 5, offset: 17819},
 							val:        "gold",
 							ignoreCase: true,
 							want:       "\"Gold\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 579, col: 5, offset: 17575},
+						pos: position{line: 588, col: 5, offset: 17864},
 						run: (*parser).callonRESOURCE12,
 						expr: &litMatcher{
-							pos:        position{line: 579, col: 5, offset: 17575},
+							pos:        position{line: 588, col: 5, offset: 17864},
 							val:        "iron ore",
 							ignoreCase: true,
 							want:       "\"Iron Ore\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 581, col: 5, offset: 17627},
+						pos: position{line: 590, col: 5, offset: 17916},
 						run: (*parser).callonRESOURCE14,
 						expr: &litMatcher{
-							pos:        position{line: 581, col: 5, offset: 17627},
+							pos:        position{line: 590, col: 5, offset: 17916},
 							val:        "jade",
 							ignoreCase: true,
 							want:       "\"Jade\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 583, col: 5, offset: 17672},
+						pos: position{line: 592, col: 5, offset: 17961},
 						run: (*parser).callonRESOURCE16,
 						expr: &litMatcher{
-							pos:        position{line: 583, col: 5, offset: 17672},
+							pos:        position{line: 592, col: 5, offset: 17961},
 							val:        "kaolin",
 							ignoreCase: true,
 							want:       "\"Kaolin\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 585, col: 5, offset: 17721},
+						pos: position{line: 594, col: 5, offset: 18010},
 						run: (*parser).callonRESOURCE18,
 						expr: &litMatcher{
-							pos:        position{line: 585, col: 5, offset: 17721},
+							pos:        position{line: 594, col: 5, offset: 18010},
 							val:        "lead ore",
 							ignoreCase: true,
 							want:       "\"Lead Ore\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 587, col: 5, offset: 17773},
+						pos: position{line: 596, col: 5, offset: 18062},
 						run: (*parser).callonRESOURCE20,
 						expr: &litMatcher{
-							pos:        position{line: 587, col: 5, offset: 17773},
+							pos:        position{line: 596, col: 5, offset: 18062},
 							val:        "limestone",
 							ignoreCase: true,
 							want:       "\"Limestone\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 589, col: 5, offset: 17828},
+						pos: position{line: 598, col: 5, offset: 18117},
 						run: (*parser).callonRESOURCE22,
 						expr: &litMatcher{
-							pos:        position{line: 589, col: 5, offset: 17828},
+							pos:        position{line: 598, col: 5, offset: 18117},
 							val:        "nickel ore",
 							ignoreCase: true,
 							want:       "\"Nickel Ore\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 591, col: 5, offset: 17884},
+						pos: position{line: 600, col: 5, offset: 18173},
 						run: (*parser).callonRESOURCE24,
 						expr: &litMatcher{
-							pos:        position{line: 591, col: 5, offset: 17884},
+							pos:        position{line: 600, col: 5, offset: 18173},
 							val:        "pearls",
 							ignoreCase: true,
 							want:       "\"Pearls\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 593, col: 5, offset: 17933},
+						pos: position{line: 602, col: 5, offset: 18222},
 						run: (*parser).callonRESOURCE26,
 						expr: &litMatcher{
-							pos:        position{line: 593, col: 5, offset: 17933},
+							pos:        position{line: 602, col: 5, offset: 18222},
 							val:        "pyrite",
 							ignoreCase: true,
 							want:       "\"Pyrite\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 595, col: 5, offset: 17982},
+						pos: position{line: 604, col: 5, offset: 18271},
 						run: (*parser).callonRESOURCE28,
 						expr: &litMatcher{
-							pos:        position{line: 595, col: 5, offset: 17982},
+							pos:        position{line: 604, col: 5, offset: 18271},
 							val:        "rubies",
 							ignoreCase: true,
 							want:       "\"Rubies\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 597, col: 5, offset: 18031},
+						pos: position{line: 606, col: 5, offset: 18320},
 						run: (*parser).callonRESOURCE30,
 						expr: &litMatcher{
-							pos:        position{line: 597, col: 5, offset: 18031},
+							pos:        position{line: 606, col: 5, offset: 18320},
 							val:        "salt",
 							ignoreCase: true,
 							want:       "\"Salt\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 599, col: 5, offset: 18076},
+						pos: position{line: 608, col: 5, offset: 18365},
 						run: (*parser).callonRESOURCE32,
 						expr: &litMatcher{
-							pos:        position{line: 599, col: 5, offset: 18076},
+							pos:        position{line: 608, col: 5, offset: 18365},
 							val:        "silver",
 							ignoreCase: true,
 							want:       "\"Silver\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 601, col: 5, offset: 18125},
+						pos: position{line: 610, col: 5, offset: 18414},
 						run: (*parser).callonRESOURCE34,
 						expr: &litMatcher{
-							pos:        position{line: 601, col: 5, offset: 18125},
+							pos:        position{line: 610, col: 5, offset: 18414},
 							val:        "sulphur",
 							ignoreCase: true,
 							want:       "\"Sulphur\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 603, col: 5, offset: 18176},
+						pos: position{line: 612, col: 5, offset: 18465},
 						run: (*parser).callonRESOURCE36,
 						expr: &litMatcher{
-							pos:        position{line: 603, col: 5, offset: 18176},
+							pos:        position{line: 612, col: 5, offset: 18465},
 							val:        "tin ore",
 							ignoreCase: true,
 							want:       "\"Tin Ore\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 605, col: 5, offset: 18226},
+						pos: position{line: 614, col: 5, offset: 18515},
 						run: (*parser).callonRESOURCE38,
 						expr: &litMatcher{
-							pos:        position{line: 605, col: 5, offset: 18226},
+							pos:        position{line: 614, col: 5, offset: 18515},
 							val:        "vanadium ore",
 							ignoreCase: true,
 							want:       "\"Vanadium Ore\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 607, col: 5, offset: 18286},
+						pos: position{line: 616, col: 5, offset: 18575},
 						run: (*parser).callonRESOURCE40,
 						expr: &litMatcher{
-							pos:        position{line: 607, col: 5, offset: 18286},
+							pos:        position{line: 616, col: 5, offset: 18575},
 							val:        "zinc ore",
 							ignoreCase: true,
 							want:       "\"Zinc Ore\"i",
@@ -3845,315 +3846,315 @@ var g = &grammar{
 		},
 		{
 			name: "TERRAIN",
-			pos:  position{line: 611, col: 1, offset: 18337},
+			pos:  position{line: 620, col: 1, offset: 18626},
 			expr: &choiceExpr{
-				pos: position{line: 611, col: 12, offset: 18348},
+				pos: position{line: 620, col: 12, offset: 18637},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 611, col: 12, offset: 18348},
+						pos: position{line: 620, col: 12, offset: 18637},
 						run: (*parser).callonTERRAIN2,
 						expr: &litMatcher{
-							pos:        position{line: 611, col: 12, offset: 18348},
+							pos:        position{line: 620, col: 12, offset: 18637},
 							val:        "ALPS",
 							ignoreCase: false,
 							want:       "\"ALPS\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 613, col: 5, offset: 18390},
+						pos: position{line: 622, col: 5, offset: 18679},
 						run: (*parser).callonTERRAIN4,
 						expr: &litMatcher{
-							pos:        position{line: 613, col: 5, offset: 18390},
+							pos:        position{line: 622, col: 5, offset: 18679},
 							val:        "ARID TUNDRA",
 							ignoreCase: false,
 							want:       "\"ARID TUNDRA\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 615, col: 5, offset: 18445},
+						pos: position{line: 624, col: 5, offset: 18734},
 						run: (*parser).callonTERRAIN6,
 						expr: &litMatcher{
-							pos:        position{line: 615, col: 5, offset: 18445},
+							pos:        position{line: 624, col: 5, offset: 18734},
 							val:        "ARID",
 							ignoreCase: false,
 							want:       "\"ARID\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 617, col: 5, offset: 18492},
+						pos: position{line: 626, col: 5, offset: 18781},
 						run: (*parser).callonTERRAIN8,
 						expr: &litMatcher{
-							pos:        position{line: 617, col: 5, offset: 18492},
+							pos:        position{line: 626, col: 5, offset: 18781},
 							val:        "BRUSH FLAT",
 							ignoreCase: false,
 							want:       "\"BRUSH FLAT\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 619, col: 5, offset: 18545},
+						pos: position{line: 628, col: 5, offset: 18834},
 						run: (*parser).callonTERRAIN10,
 						expr: &litMatcher{
-							pos:        position{line: 619, col: 5, offset: 18545},
+							pos:        position{line: 628, col: 5, offset: 18834},
 							val:        "BRUSH HILLS",
 							ignoreCase: false,
 							want:       "\"BRUSH HILLS\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 621, col: 5, offset: 18600},
+						pos: position{line: 630, col: 5, offset: 18889},
 						run: (*parser).callonTERRAIN12,
 						expr: &litMatcher{
-							pos:        position{line: 621, col: 5, offset: 18600},
+							pos:        position{line: 630, col: 5, offset: 18889},
 							val:        "BRUSH",
 							ignoreCase: false,
 							want:       "\"BRUSH\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 623, col: 5, offset: 18648},
+						pos: position{line: 632, col: 5, offset: 18937},
 						run: (*parser).callonTERRAIN14,
 						expr: &litMatcher{
-							pos:        position{line: 623, col: 5, offset: 18648},
+							pos:        position{line: 632, col: 5, offset: 18937},
 							val:        "CONIFER HILLS",
 							ignoreCase: false,
 							want:       "\"CONIFER HILLS\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 625, col: 5, offset: 18707},
+						pos: position{line: 634, col: 5, offset: 18996},
 						run: (*parser).callonTERRAIN16,
 						expr: &litMatcher{
-							pos:        position{line: 625, col: 5, offset: 18707},
+							pos:        position{line: 634, col: 5, offset: 18996},
 							val:        "DECIDUOUS FOREST",
 							ignoreCase: false,
 							want:       "\"DECIDUOUS FOREST\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 627, col: 5, offset: 18766},
+						pos: position{line: 636, col: 5, offset: 19055},
 						run: (*parser).callonTERRAIN18,
 						expr: &litMatcher{
-							pos:        position{line: 627, col: 5, offset: 18766},
+							pos:        position{line: 636, col: 5, offset: 19055},
 							val:        "DECIDUOUS HILLS",
 							ignoreCase: false,
 							want:       "\"DECIDUOUS HILLS\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 629, col: 5, offset: 18829},
+						pos: position{line: 638, col: 5, offset: 19118},
 						run: (*parser).callonTERRAIN20,
 						expr: &litMatcher{
-							pos:        position{line: 629, col: 5, offset: 18829},
+							pos:        position{line: 638, col: 5, offset: 19118},
 							val:        "DECIDUOUS",
 							ignoreCase: false,
 							want:       "\"DECIDUOUS\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 631, col: 5, offset: 18881},
+						pos: position{line: 640, col: 5, offset: 19170},
 						run: (*parser).callonTERRAIN22,
 						expr: &litMatcher{
-							pos:        position{line: 631, col: 5, offset: 18881},
+							pos:        position{line: 640, col: 5, offset: 19170},
 							val:        "DESERT",
 							ignoreCase: false,
 							want:       "\"DESERT\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 633, col: 5, offset: 18927},
+						pos: position{line: 642, col: 5, offset: 19216},
 						run: (*parser).callonTERRAIN24,
 						expr: &litMatcher{
-							pos:        position{line: 633, col: 5, offset: 18927},
+							pos:        position{line: 642, col: 5, offset: 19216},
 							val:        "GRASSY HILLS PLATEAU",
 							ignoreCase: false,
 							want:       "\"GRASSY HILLS PLATEAU\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 635, col: 5, offset: 18999},
+						pos: position{line: 644, col: 5, offset: 19288},
 						run: (*parser).callonTERRAIN26,
 						expr: &litMatcher{
-							pos:        position{line: 635, col: 5, offset: 18999},
+							pos:        position{line: 644, col: 5, offset: 19288},
 							val:        "GRASSY HILLS",
 							ignoreCase: false,
 							want:       "\"GRASSY HILLS\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 637, col: 5, offset: 19056},
+						pos: position{line: 646, col: 5, offset: 19345},
 						run: (*parser).callonTERRAIN28,
 						expr: &litMatcher{
-							pos:        position{line: 637, col: 5, offset: 19056},
+							pos:        position{line: 646, col: 5, offset: 19345},
 							val:        "HIGH SNOWY MOUNTAINS",
 							ignoreCase: false,
 							want:       "\"HIGH SNOWY MOUNTAINS\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 639, col: 5, offset: 19128},
+						pos: position{line: 648, col: 5, offset: 19417},
 						run: (*parser).callonTERRAIN30,
 						expr: &litMatcher{
-							pos:        position{line: 639, col: 5, offset: 19128},
+							pos:        position{line: 648, col: 5, offset: 19417},
 							val:        "JUNGLE HILLS",
 							ignoreCase: false,
 							want:       "\"JUNGLE HILLS\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 641, col: 5, offset: 19185},
+						pos: position{line: 650, col: 5, offset: 19474},
 						run: (*parser).callonTERRAIN32,
 						expr: &litMatcher{
-							pos:        position{line: 641, col: 5, offset: 19185},
+							pos:        position{line: 650, col: 5, offset: 19474},
 							val:        "JUNGLE",
 							ignoreCase: false,
 							want:       "\"JUNGLE\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 643, col: 5, offset: 19231},
+						pos: position{line: 652, col: 5, offset: 19520},
 						run: (*parser).callonTERRAIN34,
 						expr: &litMatcher{
-							pos:        position{line: 643, col: 5, offset: 19231},
+							pos:        position{line: 652, col: 5, offset: 19520},
 							val:        "LAKE",
 							ignoreCase: false,
 							want:       "\"LAKE\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 645, col: 5, offset: 19273},
+						pos: position{line: 654, col: 5, offset: 19562},
 						run: (*parser).callonTERRAIN36,
 						expr: &litMatcher{
-							pos:        position{line: 645, col: 5, offset: 19273},
+							pos:        position{line: 654, col: 5, offset: 19562},
 							val:        "LOW ARID MOUNTAINS",
 							ignoreCase: false,
 							want:       "\"LOW ARID MOUNTAINS\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 647, col: 5, offset: 19341},
+						pos: position{line: 656, col: 5, offset: 19630},
 						run: (*parser).callonTERRAIN38,
 						expr: &litMatcher{
-							pos:        position{line: 647, col: 5, offset: 19341},
+							pos:        position{line: 656, col: 5, offset: 19630},
 							val:        "LOW CONIFER MOUNTAINS",
 							ignoreCase: false,
 							want:       "\"LOW CONIFER MOUNTAINS\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 649, col: 5, offset: 19415},
+						pos: position{line: 658, col: 5, offset: 19704},
 						run: (*parser).callonTERRAIN40,
 						expr: &litMatcher{
-							pos:        position{line: 649, col: 5, offset: 19415},
+							pos:        position{line: 658, col: 5, offset: 19704},
 							val:        "LOW JUNGLE MOUNTAINS",
 							ignoreCase: false,
 							want:       "\"LOW JUNGLE MOUNTAINS\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 651, col: 5, offset: 19487},
+						pos: position{line: 660, col: 5, offset: 19776},
 						run: (*parser).callonTERRAIN42,
 						expr: &litMatcher{
-							pos:        position{line: 651, col: 5, offset: 19487},
+							pos:        position{line: 660, col: 5, offset: 19776},
 							val:        "LOW SNOWY MOUNTAINS",
 							ignoreCase: false,
 							want:       "\"LOW SNOWY MOUNTAINS\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 653, col: 5, offset: 19557},
+						pos: position{line: 662, col: 5, offset: 19846},
 						run: (*parser).callonTERRAIN44,
 						expr: &litMatcher{
-							pos:        position{line: 653, col: 5, offset: 19557},
+							pos:        position{line: 662, col: 5, offset: 19846},
 							val:        "LOW VOLCANIC MOUNTAINS",
 							ignoreCase: false,
 							want:       "\"LOW VOLCANIC MOUNTAINS\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 655, col: 5, offset: 19633},
+						pos: position{line: 664, col: 5, offset: 19922},
 						run: (*parser).callonTERRAIN46,
 						expr: &litMatcher{
-							pos:        position{line: 655, col: 5, offset: 19633},
+							pos:        position{line: 664, col: 5, offset: 19922},
 							val:        "OCEAN",
 							ignoreCase: false,
 							want:       "\"OCEAN\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 657, col: 5, offset: 19677},
+						pos: position{line: 666, col: 5, offset: 19966},
 						run: (*parser).callonTERRAIN48,
 						expr: &litMatcher{
-							pos:        position{line: 657, col: 5, offset: 19677},
+							pos:        position{line: 666, col: 5, offset: 19966},
 							val:        "PLATEAU GRASSY HILLS",
 							ignoreCase: false,
 							want:       "\"PLATEAU GRASSY HILLS\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 659, col: 5, offset: 19749},
+						pos: position{line: 668, col: 5, offset: 20038},
 						run: (*parser).callonTERRAIN50,
 						expr: &litMatcher{
-							pos:        position{line: 659, col: 5, offset: 19749},
+							pos:        position{line: 668, col: 5, offset: 20038},
 							val:        "PLATEAU PRAIRIE",
 							ignoreCase: false,
 							want:       "\"PLATEAU PRAIRIE\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 661, col: 5, offset: 19812},
+						pos: position{line: 670, col: 5, offset: 20101},
 						run: (*parser).callonTERRAIN52,
 						expr: &litMatcher{
-							pos:        position{line: 661, col: 5, offset: 19812},
+							pos:        position{line: 670, col: 5, offset: 20101},
 							val:        "POLAR ICE",
 							ignoreCase: false,
 							want:       "\"POLAR ICE\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 663, col: 5, offset: 19863},
+						pos: position{line: 672, col: 5, offset: 20152},
 						run: (*parser).callonTERRAIN54,
 						expr: &litMatcher{
-							pos:        position{line: 663, col: 5, offset: 19863},
+							pos:        position{line: 672, col: 5, offset: 20152},
 							val:        "PRAIRIE",
 							ignoreCase: false,
 							want:       "\"PRAIRIE\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 665, col: 5, offset: 19911},
+						pos: position{line: 674, col: 5, offset: 20200},
 						run: (*parser).callonTERRAIN56,
 						expr: &litMatcher{
-							pos:        position{line: 665, col: 5, offset: 19911},
+							pos:        position{line: 674, col: 5, offset: 20200},
 							val:        "ROCKY HILLS",
 							ignoreCase: false,
 							want:       "\"ROCKY HILLS\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 667, col: 5, offset: 19966},
+						pos: position{line: 676, col: 5, offset: 20255},
 						run: (*parser).callonTERRAIN58,
 						expr: &litMatcher{
-							pos:        position{line: 667, col: 5, offset: 19966},
+							pos:        position{line: 676, col: 5, offset: 20255},
 							val:        "SNOWY HILLS",
 							ignoreCase: false,
 							want:       "\"SNOWY HILLS\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 669, col: 5, offset: 20021},
+						pos: position{line: 678, col: 5, offset: 20310},
 						run: (*parser).callonTERRAIN60,
 						expr: &litMatcher{
-							pos:        position{line: 669, col: 5, offset: 20021},
+							pos:        position{line: 678, col: 5, offset: 20310},
 							val:        "SWAMP",
 							ignoreCase: false,
 							want:       "\"SWAMP\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 671, col: 5, offset: 20065},
+						pos: position{line: 680, col: 5, offset: 20354},
 						run: (*parser).callonTERRAIN62,
 						expr: &litMatcher{
-							pos:        position{line: 671, col: 5, offset: 20065},
+							pos:        position{line: 680, col: 5, offset: 20354},
 							val:        "TUNDRA",
 							ignoreCase: false,
 							want:       "\"TUNDRA\"",
@@ -4164,295 +4165,295 @@ var g = &grammar{
 		},
 		{
 			name: "TERRAIN_CODE",
-			pos:  position{line: 675, col: 1, offset: 20110},
+			pos:  position{line: 684, col: 1, offset: 20399},
 			expr: &choiceExpr{
-				pos: position{line: 675, col: 17, offset: 20126},
+				pos: position{line: 684, col: 17, offset: 20415},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 675, col: 17, offset: 20126},
+						pos: position{line: 684, col: 17, offset: 20415},
 						run: (*parser).callonTERRAIN_CODE2,
 						expr: &litMatcher{
-							pos:        position{line: 675, col: 17, offset: 20126},
+							pos:        position{line: 684, col: 17, offset: 20415},
 							val:        "ALPS",
 							ignoreCase: false,
 							want:       "\"ALPS\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 676, col: 5, offset: 20167},
+						pos: position{line: 685, col: 5, offset: 20456},
 						run: (*parser).callonTERRAIN_CODE4,
 						expr: &litMatcher{
-							pos:        position{line: 676, col: 5, offset: 20167},
+							pos:        position{line: 685, col: 5, offset: 20456},
 							val:        "GHP",
 							ignoreCase: false,
 							want:       "\"GHP\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 677, col: 5, offset: 20218},
+						pos: position{line: 686, col: 5, offset: 20507},
 						run: (*parser).callonTERRAIN_CODE6,
 						expr: &litMatcher{
-							pos:        position{line: 677, col: 5, offset: 20218},
+							pos:        position{line: 686, col: 5, offset: 20507},
 							val:        "HSM",
 							ignoreCase: false,
 							want:       "\"HSM\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 678, col: 5, offset: 20269},
+						pos: position{line: 687, col: 5, offset: 20558},
 						run: (*parser).callonTERRAIN_CODE8,
 						expr: &litMatcher{
-							pos:        position{line: 678, col: 5, offset: 20269},
+							pos:        position{line: 687, col: 5, offset: 20558},
 							val:        "LAM",
 							ignoreCase: false,
 							want:       "\"LAM\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 679, col: 5, offset: 20318},
+						pos: position{line: 688, col: 5, offset: 20607},
 						run: (*parser).callonTERRAIN_CODE10,
 						expr: &litMatcher{
-							pos:        position{line: 679, col: 5, offset: 20318},
+							pos:        position{line: 688, col: 5, offset: 20607},
 							val:        "LCM",
 							ignoreCase: false,
 							want:       "\"LCM\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 680, col: 5, offset: 20370},
+						pos: position{line: 689, col: 5, offset: 20659},
 						run: (*parser).callonTERRAIN_CODE12,
 						expr: &litMatcher{
-							pos:        position{line: 680, col: 5, offset: 20370},
+							pos:        position{line: 689, col: 5, offset: 20659},
 							val:        "LJM",
 							ignoreCase: false,
 							want:       "\"LJM\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 681, col: 5, offset: 20421},
+						pos: position{line: 690, col: 5, offset: 20710},
 						run: (*parser).callonTERRAIN_CODE14,
 						expr: &litMatcher{
-							pos:        position{line: 681, col: 5, offset: 20421},
+							pos:        position{line: 690, col: 5, offset: 20710},
 							val:        "LSM",
 							ignoreCase: false,
 							want:       "\"LSM\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 682, col: 5, offset: 20471},
+						pos: position{line: 691, col: 5, offset: 20760},
 						run: (*parser).callonTERRAIN_CODE16,
 						expr: &litMatcher{
-							pos:        position{line: 682, col: 5, offset: 20471},
+							pos:        position{line: 691, col: 5, offset: 20760},
 							val:        "LVM",
 							ignoreCase: false,
 							want:       "\"LVM\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 683, col: 5, offset: 20524},
+						pos: position{line: 692, col: 5, offset: 20813},
 						run: (*parser).callonTERRAIN_CODE18,
 						expr: &litMatcher{
-							pos:        position{line: 683, col: 5, offset: 20524},
+							pos:        position{line: 692, col: 5, offset: 20813},
 							val:        "PGH",
 							ignoreCase: false,
 							want:       "\"PGH\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 684, col: 5, offset: 20575},
+						pos: position{line: 693, col: 5, offset: 20864},
 						run: (*parser).callonTERRAIN_CODE20,
 						expr: &litMatcher{
-							pos:        position{line: 684, col: 5, offset: 20575},
+							pos:        position{line: 693, col: 5, offset: 20864},
 							val:        "PPR",
 							ignoreCase: false,
 							want:       "\"PPR\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 685, col: 5, offset: 20622},
+						pos: position{line: 694, col: 5, offset: 20911},
 						run: (*parser).callonTERRAIN_CODE22,
 						expr: &litMatcher{
-							pos:        position{line: 685, col: 5, offset: 20622},
+							pos:        position{line: 694, col: 5, offset: 20911},
 							val:        "AH",
 							ignoreCase: false,
 							want:       "\"AH\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 686, col: 5, offset: 20663},
+						pos: position{line: 695, col: 5, offset: 20952},
 						run: (*parser).callonTERRAIN_CODE24,
 						expr: &litMatcher{
-							pos:        position{line: 686, col: 5, offset: 20663},
+							pos:        position{line: 695, col: 5, offset: 20952},
 							val:        "AR",
 							ignoreCase: false,
 							want:       "\"AR\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 687, col: 5, offset: 20705},
+						pos: position{line: 696, col: 5, offset: 20994},
 						run: (*parser).callonTERRAIN_CODE26,
 						expr: &litMatcher{
-							pos:        position{line: 687, col: 5, offset: 20705},
+							pos:        position{line: 696, col: 5, offset: 20994},
 							val:        "BF",
 							ignoreCase: false,
 							want:       "\"BF\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 688, col: 5, offset: 20746},
+						pos: position{line: 697, col: 5, offset: 21035},
 						run: (*parser).callonTERRAIN_CODE28,
 						expr: &litMatcher{
-							pos:        position{line: 688, col: 5, offset: 20746},
+							pos:        position{line: 697, col: 5, offset: 21035},
 							val:        "BH",
 							ignoreCase: false,
 							want:       "\"BH\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 689, col: 5, offset: 20788},
+						pos: position{line: 698, col: 5, offset: 21077},
 						run: (*parser).callonTERRAIN_CODE30,
 						expr: &litMatcher{
-							pos:        position{line: 689, col: 5, offset: 20788},
+							pos:        position{line: 698, col: 5, offset: 21077},
 							val:        "CH",
 							ignoreCase: false,
 							want:       "\"CH\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 690, col: 5, offset: 20832},
+						pos: position{line: 699, col: 5, offset: 21121},
 						run: (*parser).callonTERRAIN_CODE32,
 						expr: &litMatcher{
-							pos:        position{line: 690, col: 5, offset: 20832},
+							pos:        position{line: 699, col: 5, offset: 21121},
 							val:        "DE",
 							ignoreCase: false,
 							want:       "\"DE\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 691, col: 5, offset: 20870},
+						pos: position{line: 700, col: 5, offset: 21159},
 						run: (*parser).callonTERRAIN_CODE34,
 						expr: &litMatcher{
-							pos:        position{line: 691, col: 5, offset: 20870},
+							pos:        position{line: 700, col: 5, offset: 21159},
 							val:        "DH",
 							ignoreCase: false,
 							want:       "\"DH\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 692, col: 5, offset: 20916},
+						pos: position{line: 701, col: 5, offset: 21205},
 						run: (*parser).callonTERRAIN_CODE36,
 						expr: &litMatcher{
-							pos:        position{line: 692, col: 5, offset: 20916},
+							pos:        position{line: 701, col: 5, offset: 21205},
 							val:        "GH",
 							ignoreCase: false,
 							want:       "\"GH\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 693, col: 5, offset: 20959},
+						pos: position{line: 702, col: 5, offset: 21248},
 						run: (*parser).callonTERRAIN_CODE38,
 						expr: &litMatcher{
-							pos:        position{line: 693, col: 5, offset: 20959},
+							pos:        position{line: 702, col: 5, offset: 21248},
 							val:        "JG",
 							ignoreCase: false,
 							want:       "\"JG\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 694, col: 5, offset: 20997},
+						pos: position{line: 703, col: 5, offset: 21286},
 						run: (*parser).callonTERRAIN_CODE40,
 						expr: &litMatcher{
-							pos:        position{line: 694, col: 5, offset: 20997},
+							pos:        position{line: 703, col: 5, offset: 21286},
 							val:        "JH",
 							ignoreCase: false,
 							want:       "\"JH\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 695, col: 5, offset: 21040},
+						pos: position{line: 704, col: 5, offset: 21329},
 						run: (*parser).callonTERRAIN_CODE42,
 						expr: &litMatcher{
-							pos:        position{line: 695, col: 5, offset: 21040},
+							pos:        position{line: 704, col: 5, offset: 21329},
 							val:        "PI",
 							ignoreCase: false,
 							want:       "\"PI\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 696, col: 5, offset: 21080},
+						pos: position{line: 705, col: 5, offset: 21369},
 						run: (*parser).callonTERRAIN_CODE44,
 						expr: &litMatcher{
-							pos:        position{line: 696, col: 5, offset: 21080},
+							pos:        position{line: 705, col: 5, offset: 21369},
 							val:        "PR",
 							ignoreCase: false,
 							want:       "\"PR\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 697, col: 5, offset: 21119},
+						pos: position{line: 706, col: 5, offset: 21408},
 						run: (*parser).callonTERRAIN_CODE46,
 						expr: &litMatcher{
-							pos:        position{line: 697, col: 5, offset: 21119},
+							pos:        position{line: 706, col: 5, offset: 21408},
 							val:        "RH",
 							ignoreCase: false,
 							want:       "\"RH\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 698, col: 5, offset: 21161},
+						pos: position{line: 707, col: 5, offset: 21450},
 						run: (*parser).callonTERRAIN_CODE48,
 						expr: &litMatcher{
-							pos:        position{line: 698, col: 5, offset: 21161},
+							pos:        position{line: 707, col: 5, offset: 21450},
 							val:        "SH",
 							ignoreCase: false,
 							want:       "\"SH\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 699, col: 5, offset: 21203},
+						pos: position{line: 708, col: 5, offset: 21492},
 						run: (*parser).callonTERRAIN_CODE50,
 						expr: &litMatcher{
-							pos:        position{line: 699, col: 5, offset: 21203},
+							pos:        position{line: 708, col: 5, offset: 21492},
 							val:        "SW",
 							ignoreCase: false,
 							want:       "\"SW\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 700, col: 5, offset: 21240},
+						pos: position{line: 709, col: 5, offset: 21529},
 						run: (*parser).callonTERRAIN_CODE52,
 						expr: &litMatcher{
-							pos:        position{line: 700, col: 5, offset: 21240},
+							pos:        position{line: 709, col: 5, offset: 21529},
 							val:        "TU",
 							ignoreCase: false,
 							want:       "\"TU\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 701, col: 5, offset: 21278},
+						pos: position{line: 710, col: 5, offset: 21567},
 						run: (*parser).callonTERRAIN_CODE54,
 						expr: &litMatcher{
-							pos:        position{line: 701, col: 5, offset: 21278},
+							pos:        position{line: 710, col: 5, offset: 21567},
 							val:        "D",
 							ignoreCase: false,
 							want:       "\"D\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 702, col: 5, offset: 21318},
+						pos: position{line: 711, col: 5, offset: 21607},
 						run: (*parser).callonTERRAIN_CODE56,
 						expr: &litMatcher{
-							pos:        position{line: 702, col: 5, offset: 21318},
+							pos:        position{line: 711, col: 5, offset: 21607},
 							val:        "L",
 							ignoreCase: false,
 							want:       "\"L\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 703, col: 5, offset: 21353},
+						pos: position{line: 712, col: 5, offset: 21642},
 						run: (*parser).callonTERRAIN_CODE58,
 						expr: &litMatcher{
-							pos:        position{line: 703, col: 5, offset: 21353},
+							pos:        position{line: 712, col: 5, offset: 21642},
 							val:        "O",
 							ignoreCase: false,
 							want:       "\"O\"",
@@ -4463,43 +4464,43 @@ var g = &grammar{
 		},
 		{
 			name: "UNIT_ID",
-			pos:  position{line: 706, col: 1, offset: 21388},
+			pos:  position{line: 715, col: 1, offset: 21677},
 			expr: &actionExpr{
-				pos: position{line: 706, col: 12, offset: 21399},
+				pos: position{line: 715, col: 12, offset: 21688},
 				run: (*parser).callonUNIT_ID1,
 				expr: &seqExpr{
-					pos: position{line: 706, col: 12, offset: 21399},
+					pos: position{line: 715, col: 12, offset: 21688},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 706, col: 12, offset: 21399},
+							pos:  position{line: 715, col: 12, offset: 21688},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 706, col: 18, offset: 21405},
+							pos:  position{line: 715, col: 18, offset: 21694},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 706, col: 24, offset: 21411},
+							pos:  position{line: 715, col: 24, offset: 21700},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 706, col: 30, offset: 21417},
+							pos:  position{line: 715, col: 30, offset: 21706},
 							name: "DIGIT",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 706, col: 36, offset: 21423},
+							pos: position{line: 715, col: 36, offset: 21712},
 							expr: &seqExpr{
-								pos: position{line: 706, col: 37, offset: 21424},
+								pos: position{line: 715, col: 37, offset: 21713},
 								exprs: []any{
 									&charClassMatcher{
-										pos:        position{line: 706, col: 37, offset: 21424},
+										pos:        position{line: 715, col: 37, offset: 21713},
 										val:        "[cefg]",
 										chars:      []rune{'c', 'e', 'f', 'g'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&charClassMatcher{
-										pos:        position{line: 706, col: 44, offset: 21431},
+										pos:        position{line: 715, col: 44, offset: 21720},
 										val:        "[1-9]",
 										ranges:     []rune{'1', '9'},
 										ignoreCase: false,
@@ -4514,45 +4515,45 @@ var g = &grammar{
 		},
 		{
 			name: "WINDSTRENGTH",
-			pos:  position{line: 710, col: 1, offset: 21477},
+			pos:  position{line: 719, col: 1, offset: 21766},
 			expr: &choiceExpr{
-				pos: position{line: 710, col: 17, offset: 21493},
+				pos: position{line: 719, col: 17, offset: 21782},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 710, col: 17, offset: 21493},
+						pos: position{line: 719, col: 17, offset: 21782},
 						run: (*parser).callonWINDSTRENGTH2,
 						expr: &litMatcher{
-							pos:        position{line: 710, col: 17, offset: 21493},
+							pos:        position{line: 719, col: 17, offset: 21782},
 							val:        "CALM",
 							ignoreCase: false,
 							want:       "\"CALM\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 712, col: 5, offset: 21533},
+						pos: position{line: 721, col: 5, offset: 21822},
 						run: (*parser).callonWINDSTRENGTH4,
 						expr: &litMatcher{
-							pos:        position{line: 712, col: 5, offset: 21533},
+							pos:        position{line: 721, col: 5, offset: 21822},
 							val:        "MILD",
 							ignoreCase: false,
 							want:       "\"MILD\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 714, col: 5, offset: 21573},
+						pos: position{line: 723, col: 5, offset: 21862},
 						run: (*parser).callonWINDSTRENGTH6,
 						expr: &litMatcher{
-							pos:        position{line: 714, col: 5, offset: 21573},
+							pos:        position{line: 723, col: 5, offset: 21862},
 							val:        "STRONG",
 							ignoreCase: false,
 							want:       "\"STRONG\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 716, col: 5, offset: 21617},
+						pos: position{line: 725, col: 5, offset: 21906},
 						run: (*parser).callonWINDSTRENGTH8,
 						expr: &litMatcher{
-							pos:        position{line: 716, col: 5, offset: 21617},
+							pos:        position{line: 725, col: 5, offset: 21906},
 							val:        "GALE",
 							ignoreCase: false,
 							want:       "\"GALE\"",
@@ -4563,29 +4564,29 @@ var g = &grammar{
 		},
 		{
 			name: "YEAR",
-			pos:  position{line: 720, col: 1, offset: 21656},
+			pos:  position{line: 729, col: 1, offset: 21945},
 			expr: &actionExpr{
-				pos: position{line: 720, col: 9, offset: 21664},
+				pos: position{line: 729, col: 9, offset: 21953},
 				run: (*parser).callonYEAR1,
 				expr: &seqExpr{
-					pos: position{line: 720, col: 9, offset: 21664},
+					pos: position{line: 729, col: 9, offset: 21953},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 720, col: 9, offset: 21664},
+							pos:  position{line: 729, col: 9, offset: 21953},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 720, col: 15, offset: 21670},
+							pos:  position{line: 729, col: 15, offset: 21959},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 720, col: 21, offset: 21676},
+							pos:  position{line: 729, col: 21, offset: 21965},
 							name: "DIGIT",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 720, col: 27, offset: 21682},
+							pos: position{line: 729, col: 27, offset: 21971},
 							expr: &ruleRefExpr{
-								pos:  position{line: 720, col: 27, offset: 21682},
+								pos:  position{line: 729, col: 27, offset: 21971},
 								name: "DIGIT",
 							},
 						},
@@ -4595,19 +4596,19 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 726, col: 1, offset: 21759},
+			pos:  position{line: 735, col: 1, offset: 22048},
 			expr: &notExpr{
-				pos: position{line: 726, col: 10, offset: 21768},
+				pos: position{line: 735, col: 10, offset: 22057},
 				expr: &anyMatcher{
-					line: 726, col: 11, offset: 21769,
+					line: 735, col: 11, offset: 22058,
 				},
 			},
 		},
 		{
 			name: "DIGIT",
-			pos:  position{line: 727, col: 1, offset: 21771},
+			pos:  position{line: 736, col: 1, offset: 22060},
 			expr: &charClassMatcher{
-				pos:        position{line: 727, col: 10, offset: 21780},
+				pos:        position{line: 736, col: 10, offset: 22069},
 				val:        "[0-9]",
 				ranges:     []rune{'0', '9'},
 				ignoreCase: false,
@@ -4616,9 +4617,9 @@ var g = &grammar{
 		},
 		{
 			name: "LETTER",
-			pos:  position{line: 728, col: 1, offset: 21786},
+			pos:  position{line: 737, col: 1, offset: 22075},
 			expr: &charClassMatcher{
-				pos:        position{line: 728, col: 10, offset: 21795},
+				pos:        position{line: 737, col: 10, offset: 22084},
 				val:        "[A-Z]",
 				ranges:     []rune{'A', 'Z'},
 				ignoreCase: false,
@@ -4627,11 +4628,11 @@ var g = &grammar{
 		},
 		{
 			name: "SP",
-			pos:  position{line: 729, col: 1, offset: 21801},
+			pos:  position{line: 738, col: 1, offset: 22090},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 729, col: 10, offset: 21810},
+				pos: position{line: 738, col: 10, offset: 22099},
 				expr: &charClassMatcher{
-					pos:        position{line: 729, col: 10, offset: 21810},
+					pos:        position{line: 738, col: 10, offset: 22099},
 					val:        "[ \\t]",
 					chars:      []rune{' ', '\t'},
 					ignoreCase: false,
@@ -4641,11 +4642,11 @@ var g = &grammar{
 		},
 		{
 			name: "_",
-			pos:  position{line: 730, col: 1, offset: 21817},
+			pos:  position{line: 739, col: 1, offset: 22106},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 730, col: 10, offset: 21826},
+				pos: position{line: 739, col: 10, offset: 22115},
 				expr: &charClassMatcher{
-					pos:        position{line: 730, col: 10, offset: 21826},
+					pos:        position{line: 739, col: 10, offset: 22115},
 					val:        "[ \\t]",
 					chars:      []rune{' ', '\t'},
 					ignoreCase: false,
@@ -5072,11 +5073,11 @@ func (p *parser) callonStep81() (any, error) {
 }
 
 func (c *current) onStep86(u, sui any) (any, error) {
-	found := []FoundUnit_t{{Id: u.(UnitId_t)}}
+	found := PatrolledAndFound_t{Units: []UnitId_t{u.(UnitId_t)}}
 	for _, su := range toAnySlice(sui) {
 		switch v := su.(type) {
 		case UnitId_t:
-			found = append(found, FoundUnit_t{Id: v})
+			found.Units = append(found.Units, v)
 		default:
 			panic(fmt.Errorf("unexpected type %T", v))
 		}
@@ -5241,23 +5242,23 @@ func (p *parser) callonTribeMovement1() (any, error) {
 	return p.cur.onTribeMovement1(stack["results"])
 }
 
-func (c *current) onTurnInfo1(cd, nt any) (any, error) {
+func (c *current) onTurnInfo1(cd, ts, tw, nt any) (any, error) {
+	ti := TurnInfo_t{
+		CurrentTurn: cd.(Date_t),
+		Season:      string(ts.([]byte)),
+		Weather:     string(tw.([]byte)),
+	}
 	if nt != nil {
-		return TurnInfo_t{
-			CurrentTurn: cd.(Date_t),
-			NextTurn:    nt.(Date_t),
-		}, nil
+		ti.NextTurn = nt.(TurnInfo_t).NextTurn
+		ti.ReportDate = nt.(TurnInfo_t).ReportDate
 	}
-
-	return TurnInfo_t{
-		CurrentTurn: cd.(Date_t),
-	}, nil
+	return ti, nil
 }
 
 func (p *parser) callonTurnInfo1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTurnInfo1(stack["cd"], stack["nt"])
+	return p.cur.onTurnInfo1(stack["cd"], stack["ts"], stack["tw"], stack["nt"])
 }
 
 func (c *current) onCurrentTurn1(cd any) (any, error) {
@@ -5270,25 +5271,31 @@ func (p *parser) callonCurrentTurn1() (any, error) {
 	return p.cur.onCurrentTurn1(stack["cd"])
 }
 
-func (c *current) onNextTurn1(nd any) (any, error) {
-	return nd, nil
+func (c *current) onNextTurn1(nd, rd any) (any, error) {
+	return TurnInfo_t{
+		NextTurn:   nd.(Date_t),
+		ReportDate: rd.(Date_t),
+	}, nil
 }
 
 func (p *parser) callonNextTurn1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNextTurn1(stack["nd"])
+	return p.cur.onNextTurn1(stack["nd"], stack["rd"])
 }
 
-func (c *current) onReportDate1() (any, error) {
-	//log.Printf("reportDate %q\n", c.text)
-	return c.text, nil
+func (c *current) onReportDate1(d, m, y any) (any, error) {
+	return Date_t{
+		Year:  y.(int),
+		Month: m.(int),
+		Day:   d.(int),
+	}, nil
 }
 
 func (p *parser) callonReportDate1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onReportDate1()
+	return p.cur.onReportDate1(stack["d"], stack["m"], stack["y"])
 }
 
 func (c *current) onToEOL1() (any, error) {
@@ -11,6 +11,7 @@ import (
 	"github.com/mdhender/ottomap/internal/resources"
 	"github.com/mdhender/ottomap/internal/results"
 	"github.com/mdhender/ottomap/internal/terrain"
+	"github.com/mdhender/ottomap/internal/winds"
 	"testing"
 )
 
@@ -95,6 +96,7 @@ func TestFleetMovementParse(t *testing.T) {
 				{LineNo: 1, StepNo: 1, Line: []byte("NW-GH"),
 					Result: results.Succeeded, Advance: direction.NorthWest, Report: &parser.Report_t{
 						Terrain: terrain.GrassyHills,
+						Wind:    &parser.Wind_t{UnitId: "0138f2", Strength: winds.Strong, From: direction.South},
 					},
 				},
 			},
@@ -111,6 +113,7 @@ func TestFleetMovementParse(t *testing.T) {
 							{Direction: direction.SouthEast, Terrain: terrain.LowConiferMountains},
 							{Direction: direction.South, Terrain: terrain.LowConiferMountains},
 						},
+						Wind: &parser.Wind_t{UnitId: "0138f4", Strength: winds.Mild, From: direction.NorthWest},
 					},
 				},
 				{LineNo: 1, StepNo: 2, Line: []byte("NE-LCM,  Lcm NE, SE, SW, S"),
@@ -122,6 +125,7 @@ func TestFleetMovementParse(t *testing.T) {
 							{Direction: direction.South, Terrain: terrain.LowConiferMountains},
 							{Direction: direction.SouthWest, Terrain: terrain.LowConiferMountains},
 						},
+						Wind: &parser.Wind_t{UnitId: "0138f4", Strength: winds.Mild, From: direction.NorthWest},
 					},
 				},
 				{LineNo: 1, StepNo: 3, Line: []byte("NE-LCM,  Lcm NE, SE, SW, S"),
@@ -133,6 +137,7 @@ func TestFleetMovementParse(t *testing.T) {
 							{Direction: direction.South, Terrain: terrain.LowConiferMountains},
 							{Direction: direction.SouthWest, Terrain: terrain.LowConiferMountains},
 						},
+						Wind: &parser.Wind_t{UnitId: "0138f4", Strength: winds.Mild, From: direction.NorthWest},
 					},
 				},
 			},
@@ -153,9 +158,10 @@ func TestFleetMovementParse(t *testing.T) {
 							{Direction: direction.NorthWest, Terrain: terrain.Ocean},
 						},
 						FarHorizons: []*parser.FarHorizon_t{
-							{Point: compass.North, Terrain: terrain.UnknownWater},
-							{Point: compass.NorthNorthEast, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f1", Point: compass.North, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f1", Point: compass.NorthNorthEast, Terrain: terrain.UnknownLand},
 						},
+						Wind: &parser.Wind_t{UnitId: "0138f1", Strength: winds.Mild, From: direction.NorthWest},
 					},
 				},
 			},
@@ -171,13 +177,16 @@ func TestFleetMovementParse(t *testing.T) {
 							{Direction: direction.NorthEast, Terrain: terrain.Ocean},
 						},
 						FarHorizons: []*parser.FarHorizon_t{
-							{Point: compass.North, Terrain: terrain.UnknownWater},
-							{Point: compass.NorthNorthEast, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f1", Point: compass.North, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f1", Point: compass.NorthNorthEast, Terrain: terrain.UnknownLand},
 						},
+						Wind: &parser.Wind_t{UnitId: "0138f1", Strength: winds.Mild, From: direction.NorthWest},
 					},
 				},
 				{LineNo: 1, StepNo: 2, Line: []byte("No River Adjacent to Hex to SW of HEX"),
-					Result: results.Failed, Still: true, Advance: direction.SouthWest, Report: &parser.Report_t{},
+					Result: results.Failed, Still: true, Advance: direction.SouthWest, Report: &parser.Report_t{
+						Wind: &parser.Wind_t{UnitId: "0138f1", Strength: winds.Mild, From: direction.NorthWest},
+					},
 				},
 			},
 		},
@@ -197,20 +206,21 @@ func TestFleetMovementParse(t *testing.T) {
 							{Direction: direction.NorthWest, Terrain: terrain.Ocean},
 						},
 						FarHorizons: []*parser.FarHorizon_t{
-							{Point: compass.North, Terrain: terrain.UnknownLand},
-							{Point: compass.NorthNorthEast, Terrain: terrain.UnknownLand},
-							{Point: compass.NorthEast, Terrain: terrain.UnknownWater},
-							{Point: compass.East, Terrain: terrain.UnknownWater},
-							{Point: compass.SouthEast, Terrain: terrain.UnknownWater},
-							{Point: compass.SouthSouthEast, Terrain: terrain.UnknownWater},
-							{Point: compass.South, Terrain: terrain.UnknownWater},
-							{Point: compass.SouthSouthWest, Terrain: terrain.UnknownWater},
-							{Point: compass.SouthWest, Terrain: terrain.UnknownWater},
-							{Point: compass.West, Terrain: terrain.UnknownWater},
-							{Point: compass.NorthWest, Terrain: terrain.UnknownWater},
-							{Point: compass.NorthNorthWest, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.North, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.NorthNorthEast, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.NorthEast, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.East, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.SouthEast, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.SouthSouthEast, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.South, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.SouthSouthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.SouthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.West, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.NorthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.NorthNorthWest, Terrain: terrain.UnknownLand},
 						},
 						Settlements: []*parser.Settlement_t{{Name: "The Dirty Squirrel"}},
+						Wind:        &parser.Wind_t{UnitId: "0138f7", Strength: winds.Mild, From: direction.North},
 					},
 				},
 				{LineNo: 1, StepNo: 2, Line: []byte("NW-O, -(NE GH, SE PR, N SW, S O, SW O, NW O, )(Sight Water - N/N,Sight Land - N/NE,Sight Water - N/NW,Sight Land - NE/NE,Sight Land - NE/SE,Sight Water - SE/SE,Sight Water - S/SE,Sight Water - S/S,Sight Water - S/SW,Sight Water - SW/SW,Sight Water - SW/NW,Sight Water - NW/NW, )"),
@@ -225,19 +235,20 @@ func TestFleetMovementParse(t *testing.T) {
 							{Direction: direction.NorthWest, Terrain: terrain.Ocean},
 						},
 						FarHorizons: []*parser.FarHorizon_t{
-							{Point: compass.North, Terrain: terrain.UnknownWater},
-							{Point: compass.NorthNorthEast, Terrain: terrain.UnknownLand},
-							{Point: compass.NorthEast, Terrain: terrain.UnknownLand},
-							{Point: compass.East, Terrain: terrain.UnknownLand},
-							{Point: compass.SouthEast, Terrain: terrain.UnknownWater},
-							{Point: compass.SouthSouthEast, Terrain: terrain.UnknownWater},
-							{Point: compass.South, Terrain: terrain.UnknownWater},
-							{Point: compass.SouthSouthWest, Terrain: terrain.UnknownWater},
-							{Point: compass.SouthWest, Terrain: terrain.UnknownWater},
-							{Point: compass.West, Terrain: terrain.UnknownWater},
-							{Point: compass.NorthWest, Terrain: terrain.UnknownWater},
-							{Point: compass.NorthNorthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.North, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.NorthNorthEast, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.NorthEast, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.East, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.SouthEast, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.SouthSouthEast, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.South, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.SouthSouthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.SouthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.West, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.NorthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.NorthNorthWest, Terrain: terrain.UnknownWater},
 						},
+						Wind: &parser.Wind_t{UnitId: "0138f7", Strength: winds.Mild, From: direction.North},
 					},
 				},
 				{LineNo: 1, StepNo: 3, Line: []byte("NW-O, -(NE SW, SE O, N O, S O, SW O, NW O, )(Sight Water - N/N,Sight Water - N/NE,Sight Water - N/NW,Sight Land - NE/NE,Sight Land - NE/SE,Sight Land - SE/SE,Sight Water - S/SE,Sight Water - S/S,Sight Water - S/SW,Sight Water - SW/SW,Sight Water - SW/NW,Sight Water - NW/NW, )"),
@@ -252,19 +263,20 @@ func TestFleetMovementParse(t *testing.T) {
 							{Direction: direction.NorthWest, Terrain: terrain.Ocean},
 						},
 						FarHorizons: []*parser.FarHorizon_t{
-							{Point: compass.North, Terrain: terrain.UnknownWater},
-							{Point: compass.NorthNorthEast, Terrain: terrain.UnknownWater},
-							{Point: compass.NorthEast, Terrain: terrain.UnknownLand},
-							{Point: compass.East, Terrain: terrain.UnknownLand},
-							{Point: compass.SouthEast, Terrain: terrain.UnknownLand},
-							{Point: compass.SouthSouthEast, Terrain: terrain.UnknownWater},
-							{Point: compass.South, Terrain: terrain.UnknownWater},
-							{Point: compass.SouthSouthWest, Terrain: terrain.UnknownWater},
-							{Point: compass.SouthWest, Terrain: terrain.UnknownWater},
-							{Point: compass.West, Terrain: terrain.UnknownWater},
-							{Point: compass.NorthWest, Terrain: terrain.UnknownWater},
-							{Point: compass.NorthNorthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.North, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.NorthNorthEast, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.NorthEast, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.East, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.SouthEast, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.SouthSouthEast, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.South, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.SouthSouthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.SouthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.West, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.NorthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.NorthNorthWest, Terrain: terrain.UnknownWater},
 						},
+						Wind: &parser.Wind_t{UnitId: "0138f7", Strength: winds.Mild, From: direction.North},
 					},
 				},
 				{LineNo: 1, StepNo: 4, Line: []byte("N-O, -(NE O, SE SW, N O, S O, SW O, NW O, )(Sight Land - N/N,Sight Land - N/NE,Sight Water - N/NW,Sight Land - NE/NE,Sight Land - NE/SE,Sight Land - SE/SE,Sight Water - S/SE,Sight Water - S/S,Sight Water - S/SW,Sight Water - SW/SW,Sight Water - SW/NW,Sight Water - NW/NW, )"),
@@ -279,19 +291,20 @@ func TestFleetMovementParse(t *testing.T) {
 							{Direction: direction.NorthWest, Terrain: terrain.Ocean},
 						},
 						FarHorizons: []*parser.FarHorizon_t{
-							{Point: compass.North, Terrain: terrain.UnknownLand},
-							{Point: compass.NorthNorthEast, Terrain: terrain.UnknownLand},
-							{Point: compass.NorthEast, Terrain: terrain.UnknownLand},
-							{Point: compass.East, Terrain: terrain.UnknownLand},
-							{Point: compass.SouthEast, Terrain: terrain.UnknownLand},
-							{Point: compass.SouthSouthEast, Terrain: terrain.UnknownWater},
-							{Point: compass.South, Terrain: terrain.UnknownWater},
-							{Point: compass.SouthSouthWest, Terrain: terrain.UnknownWater},
-							{Point: compass.SouthWest, Terrain: terrain.UnknownWater},
-							{Point: compass.West, Terrain: terrain.UnknownWater},
-							{Point: compass.NorthWest, Terrain: terrain.UnknownWater},
-							{Point: compass.NorthNorthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.North, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.NorthNorthEast, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.NorthEast, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.East, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.SouthEast, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.SouthSouthEast, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.South, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.SouthSouthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.SouthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.West, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.NorthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.NorthNorthWest, Terrain: terrain.UnknownWater},
 						},
+						Wind: &parser.Wind_t{UnitId: "0138f7", Strength: winds.Mild, From: direction.North},
 					},
 				},
 				{LineNo: 1, StepNo: 5, Line: []byte("N-O,  Lcm NE, N,-(NE LCM, SE O, N LCM, S O, SW O, NW O, )(Sight Land - N/N,Sight Land - N/NE,Sight Water - N/NW,Sight Land - NE/NE,Sight Land - NE/SE,Sight Land - SE/SE,Sight Land - S/SE,Sight Water - S/S,Sight Water - S/SW,Sight Water - SW/SW,Sight Water - SW/NW,Sight Water - NW/NW, )"),
@@ -306,19 +319,20 @@ func TestFleetMovementParse(t *testing.T) {
 							{Direction: direction.NorthWest, Terrain: terrain.Ocean},
 						},
 						FarHorizons: []*parser.FarHorizon_t{
-							{Point: compass.North, Terrain: terrain.UnknownLand},
-							{Point: compass.NorthNorthEast, Terrain: terrain.UnknownLand},
-							{Point: compass.NorthEast, Terrain: terrain.UnknownLand},
-							{Point: compass.East, Terrain: terrain.UnknownLand},
-							{Point: compass.SouthEast, Terrain: terrain.UnknownLand},
-							{Point: compass.SouthSouthEast, Terrain: terrain.UnknownLand},
-							{Point: compass.South, Terrain: terrain.UnknownWater},
-							{Point: compass.SouthSouthWest, Terrain: terrain.UnknownWater},
-							{Point: compass.SouthWest, Terrain: terrain.UnknownWater},
-							{Point: compass.West, Terrain: terrain.UnknownWater},
-							{Point: compass.NorthWest, Terrain: terrain.UnknownWater},
-							{Point: compass.NorthNorthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.North, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.NorthNorthEast, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.NorthEast, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.East, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.SouthEast, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.SouthSouthEast, Terrain: terrain.UnknownLand},
+							{UnitId: "0138f7", Point: compass.South, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.SouthSouthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.SouthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.West, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.NorthWest, Terrain: terrain.UnknownWater},
+							{UnitId: "0138f7", Point: compass.NorthNorthWest, Terrain: terrain.UnknownWater},
 						},
+						Wind: &parser.Wind_t{UnitId: "0138f7", Strength: winds.Mild, From: direction.North},
 					},
 				},
 				{LineNo: 1, StepNo: 6, Line: []byte("N-LCM,  Lcm NE, SE,  Ensalada sin Tomate"),
@@ -329,12 +343,13 @@ func TestFleetMovementParse(t *testing.T) {
 							{Direction: direction.SouthEast, Terrain: terrain.LowConiferMountains},
 						},
 						Settlements: []*parser.Settlement_t{{Name: "Ensalada sin Tomate"}},
+						Wind:        &parser.Wind_t{UnitId: "0138f7", Strength: winds.Mild, From: direction.North},
 					},
 				},
 			},
 		},
 	} {
-		fm, err := parser.ParseFleetMovementLine(tc.id, tc.unitId, 1, []byte(tc.line), tc.debug, tc.debug)
+		fm, err := parser.ParseFleetMovementLine(tc.id, "", tc.unitId, 1, []byte(tc.line), tc.debug, tc.debug, false)
 		if err != nil {
 			t.Errorf("id %q: parse failed: %v\n", tc.id, err)
 			continue
@@ -446,7 +461,7 @@ func TestScoutMovementParse(t *testing.T) {
 							{Direction: direction.NorthWest, Terrain: terrain.Ocean},
 						},
 						Resources:  []resources.Resource_e{resources.IronOre},
-						Encounters: []parser.UnitId_t{"0138c2", "0138c3", "1590"},
+						Encounters: []*parser.Encounter_t{{UnitId: "0138c2"}, {UnitId: "0138c3"}, {UnitId: "1590"}},
 					},
 				},
 				{LineNo: 1, StepNo: 4, Line: []byte("Can't Move on Ocean to N of HEX,  Patrolled and found 1590,  0138c2,  0138c3"),
@@ -454,7 +469,9 @@ func TestScoutMovementParse(t *testing.T) {
 						Borders: []*parser.Border_t{
 							{Direction: direction.North, Terrain: terrain.Ocean},
 						},
-						Encounters: []parser.UnitId_t{"0138c2", "0138c3", "1590"},
+						Encounters: []*parser.Encounter_t{{UnitId: "0138c2"}, {UnitId: "0138c3"}, {UnitId: "1590"}},
+						Patrols:    []*parser.Patrol_t{{UnitId: "0138e1s1", ScoutNo: 1, Found: []parser.UnitId_t{"1590", "0138c2", "0138c3"}}},
+						Obstacles:  []*parser.Obstacle_t{{Scouts: true, Direction: direction.North, Result: results.Prohibited, Terrain: terrain.Ocean}},
 					},
 				},
 			},
@@ -468,7 +485,7 @@ func TestScoutMovementParse(t *testing.T) {
 						Borders: []*parser.Border_t{
 							{Direction: direction.South, Edge: edges.River},
 						},
-						Encounters: []parser.UnitId_t{"0590"},
+						Encounters: []*parser.Encounter_t{{UnitId: "0590"}},
 					},
 				},
 				{LineNo: 1, StepNo: 2, Line: []byte("Not enough M.P's to move to SE into ROCKY HILLS,  Patrolled and found 0590"),
@@ -476,7 +493,9 @@ func TestScoutMovementParse(t *testing.T) {
 						Borders: []*parser.Border_t{
 							{Direction: direction.SouthEast, Terrain: terrain.RockyHills},
 						},
-						Encounters: []parser.UnitId_t{"0590"},
+						Encounters: []*parser.Encounter_t{{UnitId: "0590"}},
+						Patrols:    []*parser.Patrol_t{{UnitId: "0138e1s3", ScoutNo: 3, Found: []parser.UnitId_t{"0590"}}},
+						Obstacles:  []*parser.Obstacle_t{{Scouts: true, Direction: direction.SouthEast, Result: results.ExhaustedMovementPoints, Terrain: terrain.RockyHills}},
 					},
 				},
 			},
@@ -492,7 +511,7 @@ func TestScoutMovementParse(t *testing.T) {
 							{Direction: direction.South, Edge: edges.River},
 							{Direction: direction.NorthWest, Terrain: terrain.Ocean},
 						},
-						Encounters: []parser.UnitId_t{"3138"},
+						Encounters: []*parser.Encounter_t{{UnitId: "3138"}},
 					},
 				},
 				{LineNo: 1, StepNo: 2, Line: []byte("Can't Move on Ocean to N of HEX,  Patrolled and found 3138"),
@@ -500,7 +519,9 @@ func TestScoutMovementParse(t *testing.T) {
 						Borders: []*parser.Border_t{
 							{Direction: direction.North, Terrain: terrain.Ocean},
 						},
-						Encounters: []parser.UnitId_t{"3138"},
+						Encounters: []*parser.Encounter_t{{UnitId: "3138"}},
+						Patrols:    []*parser.Patrol_t{{UnitId: "0138e1s7", ScoutNo: 7, Found: []parser.UnitId_t{"3138"}}},
+						Obstacles:  []*parser.Obstacle_t{{Scouts: true, Direction: direction.North, Result: results.Prohibited, Terrain: terrain.Ocean}},
 					},
 				},
 			},
@@ -533,12 +554,13 @@ func TestScoutMovementParse(t *testing.T) {
 						Borders: []*parser.Border_t{
 							{Direction: direction.NorthWest, Terrain: terrain.Prairie},
 						},
+						Obstacles: []*parser.Obstacle_t{{Scouts: true, Direction: direction.NorthWest, Result: results.ExhaustedMovementPoints, Terrain: terrain.Prairie}},
 					},
 				},
 			},
 		},
 	} {
-		sm, err := parser.ParseScoutMovementLine(tc.id, tc.unitId, 1, []byte(tc.line), tc.debug, tc.debug)
+		sm, err := parser.ParseScoutMovementLine(tc.id, "", tc.unitId, 1, []byte(tc.line), tc.debug, tc.debug, false)
 		if err != nil {
 			t.Errorf("id %q: parse failed: %v\n", tc.id, err)
 			continue
@@ -583,9 +605,9 @@ func TestStatusLine(t *testing.T) {
 			unitId: "0138",
 			moves: []*parser.Move_t{
 				{LineNo: 1, StepNo: 1, Line: []byte("PRAIRIE, 0138"),
-					Result: results.Succeeded, Still: true, Report: &parser.Report_t{
+					Result: results.StatusLine, Still: true, Report: &parser.Report_t{
 						Terrain:    terrain.Prairie,
-						Encounters: []parser.UnitId_t{"0138"},
+						Encounters: []*parser.Encounter_t{{UnitId: "0138"}},
 					},
 				},
 			},
@@ -595,12 +617,12 @@ func TestStatusLine(t *testing.T) {
 			unitId: "0138e1",
 			moves: []*parser.Move_t{
 				{LineNo: 1, StepNo: 1, Line: []byte("PRAIRIE,River S, 0138e1"),
-					Result: results.Succeeded, Still: true, Report: &parser.Report_t{
+					Result: results.StatusLine, Still: true, Report: &parser.Report_t{
 						Terrain: terrain.Prairie,
 						Borders: []*parser.Border_t{
 							{Direction: direction.South, Edge: edges.River},
 						},
-						Encounters: []parser.UnitId_t{"0138e1"},
+						Encounters: []*parser.Encounter_t{{UnitId: "0138e1"}},
 					},
 				},
 			},
@@ -610,13 +632,13 @@ func TestStatusLine(t *testing.T) {
 			unitId: "0138",
 			moves: []*parser.Move_t{
 				{LineNo: 1, StepNo: 1, Line: []byte("PRAIRIE, O S,Ford SE, 2138, 0138"),
-					Result: results.Succeeded, Still: true, Report: &parser.Report_t{
+					Result: results.StatusLine, Still: true, Report: &parser.Report_t{
 						Terrain: terrain.Prairie,
 						Borders: []*parser.Border_t{
 							{Direction: direction.SouthEast, Edge: edges.Ford},
 							{Direction: direction.South, Terrain: terrain.Ocean},
 						},
-						Encounters: []parser.UnitId_t{"0138", "2138"},
+						Encounters: []*parser.Encounter_t{{UnitId: "0138"}, {UnitId: "2138"}},
 					},
 				},
 			},
@@ -626,12 +648,12 @@ func TestStatusLine(t *testing.T) {
 			unitId: "0138e1",
 			moves: []*parser.Move_t{
 				{LineNo: 1, StepNo: 1, Line: []byte("PRAIRIE, O NW, 0138e1"),
-					Result: results.Succeeded, Still: true, Report: &parser.Report_t{
+					Result: results.StatusLine, Still: true, Report: &parser.Report_t{
 						Terrain: terrain.Prairie,
 						Borders: []*parser.Border_t{
 							{Direction: direction.NorthWest, Terrain: terrain.Ocean},
 						},
-						Encounters: []parser.UnitId_t{"0138e1"},
+						Encounters: []*parser.Encounter_t{{UnitId: "0138e1"}},
 					},
 				},
 			},
@@ -641,20 +663,20 @@ func TestStatusLine(t *testing.T) {
 			unitId: "0138",
 			moves: []*parser.Move_t{
 				{LineNo: 1, StepNo: 1, Line: []byte("CONIFER HILLS, O SW, NW, S, 2138, 0138c1, 0138, 1138"),
-					Result: results.Succeeded, Still: true, Report: &parser.Report_t{
+					Result: results.StatusLine, Still: true, Report: &parser.Report_t{
 						Terrain: terrain.ConiferHills,
 						Borders: []*parser.Border_t{
 							{Direction: direction.South, Terrain: terrain.Ocean},
 							{Direction: direction.SouthWest, Terrain: terrain.Ocean},
 							{Direction: direction.NorthWest, Terrain: terrain.Ocean},
 						},
-						Encounters: []parser.UnitId_t{"0138", "0138c1", "1138", "2138"},
+						Encounters: []*parser.Encounter_t{{UnitId: "0138"}, {UnitId: "0138c1"}, {UnitId: "1138"}, {UnitId: "2138"}},
 					},
 				},
 			},
 		},
 	} {
-		sl, err := parser.ParseStatusLine(tc.id, tc.unitId, 1, []byte(tc.line), tc.debug, tc.debug)
+		sl, err := parser.ParseStatusLine(tc.id, "", tc.unitId, 1, []byte(tc.line), tc.debug, tc.debug, false)
 		if err != nil {
 			t.Errorf("id %q: parse failed: %v\n", tc.id, err)
 			continue
@@ -694,7 +716,7 @@ func TestTribeFollowsParse(t *testing.T) {
 		{id: "1812", line: "Tribe Follows 1812", follows: "1812"},
 		{id: "1812f3", line: "Tribe Follows 1812f3", follows: "1812f3"},
 	} {
-		tf, err := parser.ParseTribeFollowsLine(tc.id, tc.unitId, 1, []byte(tc.line), tc.debug)
+		tf, err := parser.ParseTribeFollowsLine(tc.id, "", tc.unitId, 1, []byte(tc.line), tc.debug)
 		if err != nil {
 			t.Errorf("id %q: parse failed: %v\n", tc.id, err)
 			continue
@@ -717,7 +739,7 @@ func TestTribeGoesParse(t *testing.T) {
 		{id: "2", line: "Tribe Goes to ## 1812", goesTo: "## 1812"},
 		{id: "3", line: "Tribe Goes to N/A", goesTo: "N/A"},
 	} {
-		gt, err := parser.ParseTribeGoesToLine(tc.id, tc.unitId, 1, []byte(tc.line), tc.debug)
+		gt, err := parser.ParseTribeGoesToLine(tc.id, "", tc.unitId, 1, []byte(tc.line), tc.debug)
 		if err != nil {
 			t.Errorf("id %q: parse failed: %v\n", tc.id, err)
 			continue
@@ -734,6 +756,7 @@ func TestTribeMovementParse(t *testing.T) {
 		line   string
 		unitId parser.UnitId_t
 		moves  []*parser.Move_t
+		err    bool
 		debug  bool
 	}{
 		{id: "900-01.0138",
@@ -775,9 +798,18 @@ func TestTribeMovementParse(t *testing.T) {
 				},
 			},
 		},
+		{id: "900-03.0138",
+			line: `Tribe Movement: Move SE-GH, \N`,
+			err:  true,
+		},
 	} {
-		tm, err := parser.ParseTribeMovementLine(tc.id, tc.unitId, 1, []byte(tc.line), tc.debug, tc.debug)
-		if err != nil {
+		tm, err := parser.ParseTribeMovementLine(tc.id, "", tc.unitId, 1, []byte(tc.line), tc.debug, tc.debug, false)
+		if tc.err {
+			if err == nil {
+				t.Errorf("id %q: parse: want error, got nil\n", tc.id)
+			}
+			continue
+		} else if err != nil {
 			t.Errorf("id %q: parse failed: %v\n", tc.id, err)
 			continue
 		}
@@ -5,6 +5,8 @@ package parser
 import (
 	"bytes"
 	"fmt"
+	"github.com/mdhender/ottomap/cerrs"
+	"github.com/mdhender/ottomap/internal/diagnostics"
 	"github.com/mdhender/ottomap/internal/direction"
 	"github.com/mdhender/ottomap/internal/edges"
 	"github.com/mdhender/ottomap/internal/resources"
@@ -28,7 +30,7 @@ var (
 	rxFleetSection    = regexp.MustCompile(`^Fleet \d{4}f\d, `)
 	rxFleetMovement   = regexp.MustCompile(`^(CALM|MILD|STRONG|GALE)\s(NE|SE|SW|NW|N|S)\sFleet\sMovement:\sMove\s`)
 	rxGarrisonSection = regexp.MustCompile(`^Garrison \d{4}g\d, `)
-	rxScoutLine       = regexp.MustCompile(`^Scout \d:Scout `)
+	rxScoutLine       = regexp.MustCompile(`^Scout \d:Scout[ ,]`)
 	rxTribeSection    = regexp.MustCompile(`^Tribe \d{4}, `)
 )
 
@@ -45,7 +47,11 @@ type ParseConfig struct {
 	}
 }
 
-func ParseInput(fid, tid string, input []byte, debugParser, debugSections, debugSteps, debugNodes bool, experimentalUnitSplit bool, cfg ParseConfig) (*Turn_t, error) {
+// ParseInput parses a turn report.
+// Problems are added to the diagnostics collector and parsing continues with the next line,
+// so that all the problems in the report are found in a single pass.
+// It returns cerrs.ErrParseFailed if any errors were found.
+func ParseInput(fid, tid string, input []byte, debugParser, debugSections, debugSteps, debugNodes bool, experimentalUnitSplit bool, cfg ParseConfig, dx *diagnostics.Collector_t) (*Turn_t, error) {
 	debugp := func(format string, args ...any) {
 		if debugParser {
 			log.Printf(format, args...)
@@ -61,8 +67,15 @@ func ParseInput(fid, tid string, input []byte, debugParser, debugSections, debug
 	t := &Turn_t{
 		UnitMoves: map[UnitId_t]*Moves_t{},
 	}
-	var unitId UnitId_t // current unit being parsed
-	var moves *Moves_t  // current move being parsed
+	var unitId UnitId_t  // current unit being parsed
+	var moves *Moves_t   // current move being parsed
+	skipSection := false // set when the section header is invalid
+
+	errorCount := 0
+	fail := func(lineNo int, line []byte, err error, fix string) {
+		errorCount++
+		diagnose(dx, fid, tid, unitId, lineNo, line, err, fix)
+	}
 
 	var statusLinePrefix []byte
 	for n, line := range bytes.Split(input, []byte("\n")) {
@@ -71,20 +84,29 @@ func ParseInput(fid, tid string, input []byte, debugParser, debugSections, debug
 		}
 		lineNo := n + 1
 
+		isSectionHeader := rxCourierSection.Match(line) || rxElementSection.Match(line) || rxFleetSection.Match(line) || rxGarrisonSection.Match(line) || rxTribeSection.Match(line)
+		if isSectionHeader {
+			skipSection = false
+		} else if skipSection {
+			continue
+		}
+
 		if rxCourierSection.Match(line) {
 			unitId = UnitId_t(line[8:14])
 			debugs("%s: %d: found %q\n", fid, lineNo, unitId)
 			location, err := ParseLocationLine(fid, tid, unitId, lineNo, line, debugParser)
 			if err != nil {
-				log.Printf("%s: %s: %d: location %q\n", fid, unitId, lineNo, slug(line, 14))
-				return t, nil
+				fail(lineNo, line, err, fixLocationLine)
+				moves, skipSection = nil, true
+				continue
 			} else if _, ok := t.UnitMoves[unitId]; ok {
-				log.Printf("%s: %s: %d: location %q\n", fid, unitId, lineNo, slug(line, 14))
-				return t, fmt.Errorf("duplicate unit in turn")
+				fail(lineNo, line, fmt.Errorf("duplicate unit in turn"), "remove the duplicate section for this unit from the report")
+				moves, skipSection = nil, true
+				continue
 			} else if t.Id > LastTurnCurrentLocationObscured && strings.HasPrefix(location.CurrentHex, "##") {
-				log.Printf("info: last turn current location is obscured is %s\n", LastTurnCurrentLocationObscured)
-				log.Printf("%s: %s: %d: location %q\n", fid, unitId, lineNo, location.CurrentHex)
-				return t, fmt.Errorf("current location is obscured")
+				fail(lineNo, line, fmt.Errorf("current location is obscured"), fmt.Sprintf("reports after %s should not obscure the current hex; replace \"##\" with the grid id", LastTurnCurrentLocationObscured))
+				moves, skipSection = nil, true
+				continue
 			}
 			moves = &Moves_t{TurnId: t.Id, Id: unitId, FromHex: location.PreviousHex, ToHex: location.CurrentHex}
 			t.UnitMoves[moves.Id] = moves
@@ -94,11 +116,13 @@ func ParseInput(fid, tid string, input []byte, debugParser, debugSections, debug
 			debugs("%s: %d: found %q\n", fid, lineNo, unitId)
 			location, err := ParseLocationLine(fid, tid, unitId, lineNo, line, debugParser)
 			if err != nil {
-				log.Printf("%s: %s: %d: location %q\n", fid, unitId, lineNo, slug(line, 14))
-				return t, nil
+				fail(lineNo, line, err, fixLocationLine)
+				moves, skipSection = nil, true
+				continue
 			} else if _, ok := t.UnitMoves[unitId]; ok {
-				log.Printf("%s: %s: %d: location %q\n", fid, unitId, lineNo, slug(line, 14))
-				return t, fmt.Errorf("duplicate unit in turn")
+				fail(lineNo, line, fmt.Errorf("duplicate unit in turn"), "remove the duplicate section for this unit from the report")
+				moves, skipSection = nil, true
+				continue
 			}
 			moves = &Moves_t{TurnId: t.Id, Id: unitId, FromHex: location.PreviousHex, ToHex: location.CurrentHex}
 			t.UnitMoves[moves.Id] = moves
@@ -108,11 +132,13 @@ func ParseInput(fid, tid string, input []byte, debugParser, debugSections, debug
 			debugs("%s: %d: found %q\n", fid, lineNo, unitId)
 			location, err := ParseLocationLine(fid, tid, unitId, lineNo, line, debugParser)
 			if err != nil {
-				log.Printf("%s: %s: %d: location %q\n", fid, unitId, lineNo, slug(line, 12))
-				return t, nil
+				fail(lineNo, line, err, fixLocationLine)
+				moves, skipSection = nil, true
+				continue
 			} else if _, ok := t.UnitMoves[unitId]; ok {
-				log.Printf("%s: %s: %d: location %q\n", fid, unitId, lineNo, slug(line, 12))
-				return t, fmt.Errorf("duplicate unit in turn")
+				fail(lineNo, line, fmt.Errorf("duplicate unit in turn"), "remove the duplicate section for this unit from the report")
+				moves, skipSection = nil, true
+				continue
 			}
 			moves = &Moves_t{TurnId: t.Id, Id: unitId, FromHex: location.PreviousHex, ToHex: location.CurrentHex}
 			t.UnitMoves[moves.Id] = moves
@@ -122,11 +148,13 @@ func ParseInput(fid, tid string, input []byte, debugParser, debugSections, debug
 			debugs("%s: %d: found %q\n", fid, lineNo, unitId)
 			location, err := ParseLocationLine(fid, tid, unitId, lineNo, line, debugParser)
 			if err != nil {
-				log.Printf("%s: %s: %d: location %q\n", fid, unitId, lineNo, slug(line, 15))
-				return t, nil
+				fail(lineNo, line, err, fixLocationLine)
+				moves, skipSection = nil, true
+				continue
 			} else if _, ok := t.UnitMoves[unitId]; ok {
-				log.Printf("%s: %s: %d: location %q\n", fid, unitId, lineNo, slug(line, 15))
-				return t, fmt.Errorf("duplicate unit in turn")
+				fail(lineNo, line, fmt.Errorf("duplicate unit in turn"), "remove the duplicate section for this unit from the report")
+				moves, skipSection = nil, true
+				continue
 			}
 			moves = &Moves_t{TurnId: t.Id, Id: unitId, FromHex: location.PreviousHex, ToHex: location.CurrentHex}
 			t.UnitMoves[moves.Id] = moves
@@ -136,11 +164,13 @@ func ParseInput(fid, tid string, input []byte, debugParser, debugSections, debug
 			debugs("%s: %d: found %q\n", fid, lineNo, unitId)
 			location, err := ParseLocationLine(fid, tid, unitId, lineNo, line, debugParser)
 			if err != nil {
-				log.Printf("%s: %s: %d: location %q\n", fid, unitId, lineNo, slug(line, 10))
-				return t, nil
+				fail(lineNo, line, err, fixLocationLine)
+				moves, skipSection = nil, true
+				continue
 			} else if _, ok := t.UnitMoves[unitId]; ok {
-				log.Printf("%s: %s: %d: location %q\n", fid, unitId, lineNo, slug(line, 10))
-				return t, fmt.Errorf("duplicate unit in turn")
+				fail(lineNo, line, fmt.Errorf("duplicate unit in turn"), "remove the duplicate section for this unit from the report")
+				moves, skipSection = nil, true
+				continue
 			}
 			moves = &Moves_t{TurnId: t.Id, Id: unitId, FromHex: location.PreviousHex, ToHex: location.CurrentHex}
 			t.UnitMoves[moves.Id] = moves
@@ -150,8 +180,7 @@ func ParseInput(fid, tid string, input []byte, debugParser, debugSections, debug
 		} else if bytes.HasPrefix(line, []byte("Current Turn ")) {
 			debugs("%s: %d: found %q\n", fid, lineNo, slug(line, 19))
 			if va, err := Parse(fid, line, Entrypoint("TurnInfo")); err != nil {
-				log.Printf("%s: %s: %d: error parsing turn info", fid, unitId, lineNo)
-				return t, err
+				fail(lineNo, line, newError(line, err), "check the spelling of the \"Current Turn\" line")
 			} else if turnInfo, ok := va.(TurnInfo_t); !ok {
 				log.Printf("%s: %s: %d: error parsing turn info", fid, unitId, lineNo)
 				log.Printf("error: parser.TurnInfo_t, got %T\n", va)
@@ -163,9 +192,14 @@ func ParseInput(fid, tid string, input []byte, debugParser, debugSections, debug
 					t.Id = fmt.Sprintf("%04d-%02d", t.Year, t.Month)
 				}
 				if turnInfo.CurrentTurn.Year != t.Year || turnInfo.CurrentTurn.Month != t.Month {
-					log.Printf("%s: %s: %d: current turn: %04d-%02d", fid, unitId, lineNo, t.Year, t.Month)
-					log.Printf("%s: %s: %d:    unit turn: %04d-%02d", fid, unitId, lineNo, turnInfo.CurrentTurn.Year, turnInfo.CurrentTurn.Month)
-					return t, fmt.Errorf("turn mismatch in report")
+					fail(lineNo, line, fmt.Errorf("turn mismatch in report: expected %04d-%02d, got %04d-%02d", t.Year, t.Month, turnInfo.CurrentTurn.Year, turnInfo.CurrentTurn.Month), "make sure the report contains only one turn")
+				}
+				if t.Season == "" {
+					t.Season, t.Weather = turnInfo.Season, turnInfo.Weather
+				}
+				if t.NextTurnId == "" && !turnInfo.NextTurn.IsZero() {
+					t.NextTurnId = fmt.Sprintf("%04d-%02d", turnInfo.NextTurn.Year, turnInfo.NextTurn.Month)
+					t.ReportDate = fmt.Sprintf("%04d-%02d-%02d", turnInfo.ReportDate.Year, turnInfo.ReportDate.Month, turnInfo.ReportDate.Day)
 				}
 			}
 		} else if rxFleetMovement.Match(line) {
@@ -176,42 +210,36 @@ func ParseInput(fid, tid string, input []byte, debugParser, debugSections, debug
 			debugs("%s: %s: %d: found %q\n", fid, unitId, lineNo, pfx)
 			unitMoves, err := ParseFleetMovementLine(fid, tid, unitId, lineNo, line, debugSteps, debugNodes, experimentalUnitSplit)
 			if err != nil {
-				return t, err
-			}
-			if len(unitMoves) > 0 {
+				fail(lineNo, line, err, fixMovementLine)
+			} else if len(unitMoves) > 0 {
 				moves.Moves = append(moves.Moves, unitMoves...)
 			}
 		} else if bytes.HasPrefix(line, []byte("Tribe Follows ")) {
 			debugs("%s: %s: %d: found %q\n", fid, unitId, lineNo, slug(line, 13))
 			if moves.Follows != "" {
-				log.Printf("error: %s: %s: %d: found multiple follows\n", fid, unitId, lineNo)
-				return t, fmt.Errorf("multiple follows")
-			}
-			followMove, err := ParseTribeFollowsLine(fid, tid, unitId, lineNo, line, false)
-			if err != nil {
-				return t, err
+				fail(lineNo, line, fmt.Errorf("multiple follows"), "remove the extra \"Tribe Follows\" line")
+			} else if followMove, err := ParseTribeFollowsLine(fid, tid, unitId, lineNo, line, false); err != nil {
+				fail(lineNo, line, err, "check the spelling of the unit id on the \"Tribe Follows\" line")
+			} else {
+				moves.Follows = followMove.Follows
+				moves.Moves = append(moves.Moves, followMove)
 			}
-			moves.Follows = followMove.Follows
-			moves.Moves = append(moves.Moves, followMove)
 		} else if bytes.HasPrefix(line, []byte("Tribe Goes to ")) {
 			debugs("%s: %s: %d: found %q\n", fid, unitId, lineNo, slug(line, 14))
 			if moves.GoesTo != "" {
-				log.Printf("error: %s: %s: %d: found multiple goes to\n", fid, unitId, lineNo)
-				return t, fmt.Errorf("multiple goes to")
-			}
-			goesToMove, err := ParseTribeGoesToLine(fid, tid, unitId, lineNo, line, false)
-			if err != nil {
-				return t, err
+				fail(lineNo, line, fmt.Errorf("multiple goes to"), "remove the extra \"Tribe Goes to\" line")
+			} else if goesToMove, err := ParseTribeGoesToLine(fid, tid, unitId, lineNo, line, false); err != nil {
+				fail(lineNo, line, err, "check the spelling of the hex on the \"Tribe Goes to\" line")
+			} else {
+				moves.GoesTo = goesToMove.GoesTo
+				moves.Moves = append(moves.Moves, goesToMove)
 			}
-			moves.GoesTo = goesToMove.GoesTo
-			moves.Moves = append(moves.Moves, goesToMove)
 		} else if bytes.HasPrefix(line, []byte("Tribe Movement: ")) {
 			debugs("%s: %s: %d: found %q\n", fid, unitId, lineNo, slug(line, 14))
 			unitMoves, err := ParseTribeMovementLine(fid, tid, unitId, lineNo, line, debugSteps, debugNodes, experimentalUnitSplit)
 			if err != nil {
-				return t, err
-			}
-			if len(unitMoves) > 0 {
+				fail(lineNo, line, err, fixMovementLine)
+			} else if len(unitMoves) > 0 {
 				moves.Moves = append(moves.Moves, unitMoves...)
 			}
 		} else if rxScoutLine.Match(line) {
@@ -224,17 +252,17 @@ func ParseInput(fid, tid string, input []byte, debugParser, debugSections, debug
 				debugs("%s: %s: %d: found %q\n", fid, unitId, lineNo, slug(line, 14))
 				scoutMoves, err := ParseScoutMovementLine(fid, tid, unitId, lineNo, line, debugSteps, debugNodes, experimentalUnitSplit)
 				if err != nil {
-					return t, err
+					fail(lineNo, line, err, fixMovementLine)
+				} else {
+					moves.Scouts = append(moves.Scouts, scoutMoves)
 				}
-				moves.Scouts = append(moves.Scouts, scoutMoves)
 			}
 		} else if bytes.HasPrefix(line, statusLinePrefix) {
 			debugs("%s: %s: %d: found %q\n", fid, unitId, lineNo, statusLinePrefix)
 			statusMoves, err := ParseStatusLine(fid, tid, unitId, lineNo, line, debugSteps, debugNodes, experimentalUnitSplit)
 			if err != nil {
-				return t, err
-			}
-			if len(statusMoves) > 0 {
+				fail(lineNo, line, err, "check the spelling and punctuation of the status line; see docs/ERRORS.md")
+			} else if len(statusMoves) > 0 {
 				moves.Moves = append(moves.Moves, statusMoves...)
 			}
 		}
@@ -242,16 +270,28 @@ func ParseInput(fid, tid string, input []byte, debugParser, debugSections, debug
 
 	// stuff the turn id into all the moves so that sammy can sort them later
 	turnId := fmt.Sprintf("%04d-%02d", t.Year, t.Month)
+
+	// the rest of the report is parsed in a separate pass
+	t.Sections = ParseSections(fid, turnId, input, dx)
 	for _, v := range t.UnitMoves {
-		v.TurnId = turnId
+		v.TurnId, v.ReportId = turnId, fid
 		for _, move := range v.Moves {
 			move.TurnId = turnId
 		}
 	}
 
+	if errorCount != 0 {
+		return t, cerrs.ErrParseFailed
+	}
 	return t, nil
 }
 
+// suggested fixes for common errors
+const (
+	fixLocationLine = "check the location line; it should look like \"Tribe 0987, , Current Hex = AA 0101, (Previous Hex = AA 0101)\""
+	fixMovementLine = "check the spelling and punctuation of the step at the column shown; see docs/ERRORS.md"
+)
+
 func slug(b []byte, n int) string {
 	if len(b) < n {
 		return string(b)
@@ -322,14 +362,16 @@ type Step_t struct {
 // ParseFleetMovementLine parses a fleet movement line.
 // It returns the generic struct that covers all the known movement steps and cases.
 func ParseFleetMovementLine(fid, tid string, unitId UnitId_t, lineNo int, line []byte, debugSteps, debugNodes bool, experimentalUnitSplit bool) ([]*Move_t, error) {
+	var wind *Wind_t
 	if va, err := Parse(fid, line, Entrypoint("FleetMovement")); err != nil {
-		return nil, err
+		return nil, newError(line, err)
 	} else if mt, ok := va.(Movement_t); !ok {
 		log.Printf("%s: %s: %d: %q\n", fid, unitId, lineNo, line)
 		log.Printf("error: want Movement_t, got %T\n", va)
 		log.Printf("please report this error\n")
 		panic(fmt.Errorf("unexpected type %T\n", va))
 	} else {
+		wind = &Wind_t{TurnId: tid, UnitId: unitId, Strength: mt.Winds.Strength, From: mt.Winds.From}
 		line = mt.Text
 	}
 	if debugSteps {
@@ -338,17 +380,28 @@ func ParseFleetMovementLine(fid, tid string, unitId UnitId_t, lineNo int, line [
 
 	// remove the prefix and trim the line
 	if !bytes.HasPrefix(line, []byte{'M', 'o', 'v', 'e'}) {
-		return nil, fmt.Errorf("expected 'Move', found '%s'", slug(line, 12))
+		return nil, &Error_t{Text: line, Column: 1, Err: fmt.Errorf("expected 'Move', found '%s'", slug(line, 12))}
 	}
 	line = bytes.TrimPrefix(line, []byte{'M', 'o', 'v', 'e'})
 
-	return parseMovementLine(fid, tid, unitId, lineNo, line, debugSteps, debugNodes, experimentalUnitSplit)
+	// the wind and the crow's-nest sightings are tagged with the fleet so that
+	// we know who reported them after they are merged into the map.
+	moves, err := parseMovementLine(fid, tid, unitId, lineNo, line, debugSteps, debugNodes, experimentalUnitSplit)
+	if err != nil {
+		return nil, err
+	}
+	for _, move := range moves {
+		move.Report.Wind = wind
+		for _, fh := range move.Report.FarHorizons {
+			fh.TurnId, fh.UnitId = tid, unitId
+		}
+	}
+	return moves, nil
 }
 
 func ParseLocationLine(fid, tid string, unitId UnitId_t, lineNo int, line []byte, debug bool) (Location_t, error) {
 	if va, err := Parse(fid, line, Entrypoint("Location")); err != nil {
-		log.Printf("%s: %s: %d: %q\n", fid, unitId, lineNo, slug(line, 14))
-		return Location_t{}, err
+		return Location_t{}, newError(line, err)
 	} else if location, ok := va.(Location_t); !ok {
 		log.Printf("%s: %s: %d: location: %q\n", fid, unitId, lineNo, slug(line, 15))
 		log.Printf("error: invalid type\n")
@@ -367,7 +420,7 @@ func ParseScoutMovementLine(fid, tid string, unitId UnitId_t, lineNo int, line [
 	}
 
 	if va, err := Parse(fid, line, Entrypoint("ScoutMovement")); err != nil {
-		return nil, err
+		return nil, newError(line, err)
 	} else if mt, ok := va.(Movement_t); !ok {
 		log.Printf("%s: %s: %d: %q\n", fid, unitId, lineNo, line)
 		log.Printf("error: want Movement_t, got %T\n", va)
@@ -383,10 +436,11 @@ func ParseScoutMovementLine(fid, tid string, unitId UnitId_t, lineNo int, line [
 
 	// remove the prefix and trim the line
 	if !bytes.HasPrefix(line, []byte{'S', 'c', 'o', 'u', 't'}) {
-		log.Printf("%s: %s: %d: %q\n", fid, unitId, lineNo, line)
-		return nil, fmt.Errorf("expected 'Scout', found '%s'", slug(line, 8))
+		return nil, &Error_t{Text: line, Column: 1, Err: fmt.Errorf("expected 'Scout', found '%s'", slug(line, 8))}
 	}
 	line = bytes.TrimPrefix(line, []byte{'S', 'c', 'o', 'u', 't'})
+	// patrols that fail to move are reported as "Scout, can't Move on ..."
+	line = bytes.TrimLeft(line, ", ")
 
 	// parse the moves and then update each with the turn we did the scouting in
 	moves, err := parseMovementLine(fid, tid, unitId, lineNo, line, debugSteps, debugNodes, experimentalUnitSplit)
@@ -396,6 +450,12 @@ func ParseScoutMovementLine(fid, tid string, unitId UnitId_t, lineNo int, line [
 	for _, move := range moves {
 		move.Report.TurnId = tid
 		move.Report.ScoutedTurnId = tid
+		for _, patrol := range move.Report.Patrols {
+			patrol.ScoutNo = scout.No
+		}
+		for _, obstacle := range move.Report.Obstacles {
+			obstacle.Scouts = true
+		}
 	}
 	scout.Moves = moves
 
@@ -404,8 +464,7 @@ func ParseScoutMovementLine(fid, tid string, unitId UnitId_t, lineNo int, line [
 
 func ParseStatusLine(fid, tid string, unitId UnitId_t, lineNo int, line []byte, debugSteps, debugNodes bool, experimentalUnitSplit bool) ([]*Move_t, error) {
 	if va, err := Parse(fid, line, Entrypoint("StatusLine")); err != nil {
-		log.Printf("status %v\n", err)
-		return nil, err
+		return nil, newError(line, err)
 	} else if mt, ok := va.(Movement_t); !ok {
 		log.Printf("%s: %s: %d: %q\n", fid, unitId, lineNo, line)
 		log.Printf("error: want Movement_t, got %T\n", va)
@@ -430,7 +489,7 @@ func ParseStatusLine(fid, tid string, unitId UnitId_t, lineNo int, line []byte,
 func ParseTribeFollowsLine(fid, tid string, unitId UnitId_t, lineNo int, line []byte, debug bool) (*Move_t, error) {
 	var follows UnitId_t
 	if va, err := Parse(fid, line, Entrypoint("TribeFollows")); err != nil {
-		return nil, err
+		return nil, newError(line, err)
 	} else if mt, ok := va.(Movement_t); !ok {
 		log.Printf("%s: %s: %d: %q\n", fid, unitId, lineNo, line)
 		log.Printf("error: want Movement_t, got %T\n", va)
@@ -456,7 +515,7 @@ func ParseTribeFollowsLine(fid, tid string, unitId UnitId_t, lineNo int, line []
 func ParseTribeGoesToLine(fid, tid string, unitId UnitId_t, lineNo int, line []byte, debug bool) (*Move_t, error) {
 	var goesTo string
 	if va, err := Parse(fid, line, Entrypoint("TribeGoesTo")); err != nil {
-		return nil, err
+		return nil, newError(line, err)
 	} else if mt, ok := va.(Movement_t); !ok {
 		log.Printf("%s: %s: %d: %q\n", fid, unitId, lineNo, line)
 		log.Printf("error: want Movement_t, got %T\n", va)
@@ -481,7 +540,7 @@ func ParseTribeGoesToLine(fid, tid string, unitId UnitId_t, lineNo int, line []b
 
 func ParseTribeMovementLine(fid, tid string, unitId UnitId_t, lineNo int, line []byte, debugSteps, debugNodes bool, experimentalUnitSplit bool) ([]*Move_t, error) {
 	if va, err := Parse(fid, line, Entrypoint("TribeMovement")); err != nil {
-		return nil, err
+		return nil, newError(line, err)
 	} else if mt, ok := va.(Movement_t); !ok {
 		log.Printf("%s: %s: %d: %q\n", fid, unitId, lineNo, line)
 		log.Printf("error: want Movement_t, got %T\n", va)
@@ -496,7 +555,7 @@ func ParseTribeMovementLine(fid, tid string, unitId UnitId_t, lineNo int, line [
 
 	// remove the prefix
 	if !bytes.HasPrefix(line, []byte{'M', 'o', 'v', 'e'}) {
-		return nil, fmt.Errorf("expected 'Move', found '%s'", slug(line, 8))
+		return nil, &Error_t{Text: line, Column: 1, Err: fmt.Errorf("expected 'Move', found '%s'", slug(line, 8))}
 	}
 	line = bytes.TrimPrefix(line, []byte{'M', 'o', 'v', 'e'})
 
@@ -547,17 +606,17 @@ func parseMovementLine(fid, tid string, unitId UnitId_t, lineNo int, line []byte
 			innerRing, outerRing, ok = bytes.Cut(innerRing, []byte{')', '('})
 			if !ok {
 				log.Printf("%s: %s: %d: step %d: iring %q\n", fid, unitId, lineNo, move.StepNo, innerRing)
-				return nil, fmt.Errorf("inner ring contains '-(' but not ')(")
+				return nil, &Error_t{Text: move.Line, Column: 1, Err: fmt.Errorf("inner ring contains '-(' but not ')(")}
 			}
 			// outer ring must end with a closing parentheses
 			if bytes.IndexByte(outerRing, ')') == -1 {
 				log.Printf("%s: %s: %d: step %d: oring %q\n", fid, unitId, lineNo, move.StepNo, outerRing)
-				return nil, fmt.Errorf("outer ring missing ')'")
+				return nil, &Error_t{Text: outerRing, Column: 1, Err: fmt.Errorf("outer ring missing ')'")}
 			}
 			// outer ring must end with a closing parentheses
 			if outerRing[len(outerRing)-1] != ')' {
 				log.Printf("%s: %s: %d: step %d: oring %q\n", fid, unitId, lineNo, move.StepNo, outerRing)
-				return nil, fmt.Errorf("outer ring contains text after ')'")
+				return nil, &Error_t{Text: outerRing, Column: 1, Err: fmt.Errorf("outer ring contains text after ')'")}
 			}
 			// remove that parentheses to make later processing simpler
 			outerRing = outerRing[:len(outerRing)-1]
@@ -598,7 +657,7 @@ func parseMovementLine(fid, tid string, unitId UnitId_t, lineNo int, line []byte
 				if va, err := Parse(fid, obs, Entrypoint("DeckObservation")); err != nil {
 					log.Printf("%s: %s: %d: step %d: deck %q\n", fid, unitId, lineNo, move.StepNo, slug(innerRing, 44))
 					log.Printf("%s: %s: %d: step %d: deck %d: obs %q\n", fid, unitId, lineNo, move.StepNo, no+1, obs)
-					return nil, err
+					return nil, newError(obs, err)
 				} else if deckObservation, ok := va.(NearHorizon_t); !ok {
 					log.Printf("%s: %s: %d: step %d: deck %q\n", fid, unitId, lineNo, move.StepNo, slug(innerRing, 44))
 					log.Printf("%s: %s: %d: step %d: deck %d: obs %q\n", fid, unitId, lineNo, move.StepNo, no+1, obs)
@@ -630,7 +689,7 @@ func parseMovementLine(fid, tid string, unitId UnitId_t, lineNo int, line []byte
 				crowNo := nn + 1
 				if va, err := Parse(fid, orStep, Entrypoint("CrowsNestObservation")); err != nil {
 					log.Printf("%s: %s: %d: step %d: crow %d: %q\n", fid, unitId, lineNo, move.StepNo, crowNo, orStep)
-					return nil, err
+					return nil, newError(orStep, err)
 				} else if fh, ok := va.(FarHorizon_t); !ok {
 					log.Printf("%s: %s: %d: step %d: crow %d: %q\n", fid, unitId, lineNo, move.StepNo, crowNo, orStep)
 					log.Printf("error: want FarHorizon_t, got %T", va)
@@ -660,10 +719,10 @@ func parseMovementLine(fid, tid string, unitId UnitId_t, lineNo int, line []byte
 		if len(move.Report.Encounters) != 0 {
 			sort.Slice(move.Report.Encounters, func(i, j int) bool {
 				a, b := move.Report.Encounters[i], move.Report.Encounters[j]
-				if a.TurnId < b.TurnId {
-					return a.UnitId < b.UnitId
+				if a.TurnId != b.TurnId {
+					return a.TurnId < b.TurnId
 				}
-				return false
+				return a.UnitId < b.UnitId
 			})
 		}
 		if len(move.Report.FarHorizons) != 0 {
@@ -706,7 +765,7 @@ func parseMove(fid, tid string, unitId UnitId_t, lineNo, stepNo int, line []byte
 	steps, err := nodesToSteps(root)
 	if err != nil {
 		log.Printf("parser: %s: %s: %d: step %d: %q\n", fid, unitId, lineNo, stepNo, line)
-		return nil, err
+		return nil, &Error_t{Text: line, Column: 1, Err: err}
 	}
 
 	// parse and report on each step of this move separately.
@@ -730,14 +789,16 @@ func parseMove(fid, tid string, unitId UnitId_t, lineNo, stepNo int, line []byte
 			if err != nil {
 				log.Printf("%s: %s: %d: step %d: sub %d: %q\n", fid, unitId, lineNo, stepNo, subStepNo, subStep)
 				log.Printf("error: %v\n", err)
-				return nil, fmt.Errorf("error parsing step")
+				pe := newError(subStep, err)
+				pe.Err = fmt.Errorf("error parsing step: %w", pe.Err)
+				return nil, pe
 			}
 		}
 		switch v := obj.(type) {
 		case *BlockedByEdge_t:
 			if m.Result != results.Unknown { // only allowed at the beginning of the step
 				log.Printf("%s: %s: %d: step %d: sub %d: %q\n", fid, unitId, lineNo, stepNo, subStepNo, subStep)
-				return nil, fmt.Errorf("blocked by must start sub-step")
+				return nil, &Error_t{Text: subStep, Column: 1, Err: fmt.Errorf("blocked by must start sub-step")}
 			}
 			m.Advance = v.Direction
 			m.Result = results.Failed
@@ -745,10 +806,11 @@ func parseMove(fid, tid string, unitId UnitId_t, lineNo, stepNo int, line []byte
 				Direction: v.Direction,
 				Edge:      v.Edge,
 			})
+			m.Report.MergeObstacles(&Obstacle_t{TurnId: tid, UnitType: unitId.Type(), Direction: v.Direction, Result: results.Blocked, Edge: v.Edge})
 		case DirectionTerrain_t:
 			if m.Result != results.Unknown { // only allowed at the beginning of the step
 				log.Printf("%s: %s: %d: step %d: sub %d: %q\n", fid, unitId, lineNo, stepNo, subStepNo, subStep)
-				return nil, fmt.Errorf("multiple direction-terrain forbidden")
+				return nil, &Error_t{Text: subStep, Column: 1, Err: fmt.Errorf("multiple direction-terrain forbidden")}
 			}
 			m.Advance = v.Direction
 			m.Result = results.Succeeded
@@ -756,7 +818,7 @@ func parseMove(fid, tid string, unitId UnitId_t, lineNo, stepNo int, line []byte
 		case []*Edge_t:
 			if m.Result == results.Unknown {
 				log.Printf("%s: %s: %d: step %d: sub %d: %q\n", fid, unitId, lineNo, stepNo, subStepNo, subStep)
-				return nil, fmt.Errorf("edges forbidden at beginning of step")
+				return nil, &Error_t{Text: subStep, Column: 1, Err: fmt.Errorf("edges forbidden at beginning of step")}
 			}
 			for _, edge := range v {
 				m.Report.MergeBorders(&Border_t{
@@ -767,7 +829,7 @@ func parseMove(fid, tid string, unitId UnitId_t, lineNo, stepNo int, line []byte
 		case *Exhausted_t:
 			if m.Result != results.Unknown { // only allowed at the beginning of the step
 				log.Printf("%s: %s: %d: step %d: sub %d: %q\n", fid, unitId, lineNo, stepNo, subStepNo, subStep)
-				return nil, fmt.Errorf("exhaustion must start step")
+				return nil, &Error_t{Text: subStep, Column: 1, Err: fmt.Errorf("exhaustion must start step")}
 			}
 			m.Advance = v.Direction
 			m.Result = results.Failed
@@ -775,8 +837,28 @@ func parseMove(fid, tid string, unitId UnitId_t, lineNo, stepNo int, line []byte
 				Direction: v.Direction,
 				Terrain:   v.Terrain,
 			})
-		case FoundItem_t: // ignore
-			// log.Printf("%s: %s: %d: step %d: sub %d: %q\n", fid, unitId, lineNo, stepNo, subStepNo, subStep)
+			m.Report.MergeObstacles(&Obstacle_t{TurnId: tid, UnitType: unitId.Type(), Direction: v.Direction, Result: results.ExhaustedMovementPoints, Terrain: v.Terrain})
+		case DidNotReturn_t:
+			// the scouts were lost in the hex they were in, so this step doesn't move
+			if m.Result == results.Unknown {
+				m.Still = true
+			}
+			m.Report.MergePatrols(&Patrol_t{TurnId: tid, UnitId: unitId, Lost: true})
+		case NoGroupsFound_t:
+			if m.Result == results.Unknown {
+				m.Still = true
+			}
+			m.Report.MergePatrols(&Patrol_t{TurnId: tid, UnitId: unitId})
+		case PatrolledAndFound_t:
+			if m.Result == results.Unknown {
+				m.Still = true
+			}
+			m.Report.MergePatrols(&Patrol_t{TurnId: tid, UnitId: unitId, Found: v.Units})
+			for _, id := range v.Units {
+				m.Report.MergeEncounters(&Encounter_t{TurnId: tid, UnitId: id})
+			}
+		case FoundItem_t:
+			m.Report.Items = m.Report.mergeItems(m.Report.Items, &FoundItem_t{TurnId: tid, Quantity: v.Quantity, Item: v.Item})
 		case FoundNothing_t:
 			// mostly ignore, except for the case of where this is the entire step
 			if m.Result == results.Unknown {
@@ -786,24 +868,25 @@ func parseMove(fid, tid string, unitId UnitId_t, lineNo, stepNo int, line []byte
 		case FoundUnit_t:
 			if m.Result == results.Unknown {
 				log.Printf("%s: %s: %d: step %d: sub %d: %q\n", fid, unitId, lineNo, stepNo, subStepNo, subStep)
-				return m, fmt.Errorf("units forbidden at beginning of step")
+				return m, &Error_t{Text: subStep, Column: 1, Err: fmt.Errorf("units forbidden at beginning of step")}
 			}
 			m.Report.MergeEncounters(&Encounter_t{TurnId: tid, UnitId: v.Id})
 		case []FoundUnit_t:
 			if m.Result == results.Unknown {
 				log.Printf("%s: %s: %d: step %d: sub %d: %q\n", fid, unitId, lineNo, stepNo, subStepNo, subStep)
-				return nil, fmt.Errorf("units forbidden at beginning of step")
+				return nil, &Error_t{Text: subStep, Column: 1, Err: fmt.Errorf("units forbidden at beginning of step")}
 			}
 			for _, unit := range v {
 				m.Report.MergeEncounters(&Encounter_t{TurnId: tid, UnitId: unit.Id})
 			}
-		case Longhouse_t: // ignore
+		case Longhouse_t:
+			m.Report.MergeLonghouses(&Longhouse_t{TurnId: tid, Id: v.Id, Capacity: v.Capacity})
 		case MissingEdge_t:
 			m.Result, m.Still, m.Advance = results.Failed, true, v.Direction
 		case []*Neighbor_t:
 			if m.Result == results.Unknown {
 				log.Printf("%s: %s: %d: step %d: sub %d: %q\n", fid, unitId, lineNo, stepNo, subStepNo, subStep)
-				return nil, fmt.Errorf("neighbors forbidden at beginning of step")
+				return nil, &Error_t{Text: subStep, Column: 1, Err: fmt.Errorf("neighbors forbidden at beginning of step")}
 			}
 			for _, neighbor := range v {
 				m.Report.MergeBorders(&Border_t{
@@ -814,7 +897,7 @@ func parseMove(fid, tid string, unitId UnitId_t, lineNo, stepNo int, line []byte
 		case *ProhibitedFrom_t:
 			if m.Result != results.Unknown { // only allowed at the beginning of the step
 				log.Printf("%s: %s: %d: step %d: sub %d: %q\n", fid, unitId, lineNo, stepNo, subStepNo, subStep)
-				return nil, fmt.Errorf("prohibition must start step")
+				return nil, &Error_t{Text: subStep, Column: 1, Err: fmt.Errorf("prohibition must start step")}
 			}
 			m.Advance = v.Direction
 			m.Result = results.Failed
@@ -822,30 +905,34 @@ func parseMove(fid, tid string, unitId UnitId_t, lineNo, stepNo int, line []byte
 				Direction: v.Direction,
 				Terrain:   v.Terrain,
 			})
+			m.Report.MergeObstacles(&Obstacle_t{TurnId: tid, UnitType: unitId.Type(), Direction: v.Direction, Result: results.Prohibited, Terrain: v.Terrain})
 		case resources.Resource_e:
 			if m.Result == results.Unknown {
 				log.Printf("%s: %s: %d: step %d: sub %d: %q\n", fid, unitId, lineNo, stepNo, subStepNo, subStep)
-				return nil, fmt.Errorf("resources forbidden at beginning of step")
+				return nil, &Error_t{Text: subStep, Column: 1, Err: fmt.Errorf("resources forbidden at beginning of step")}
 			}
 			m.Report.MergeResources(v)
 		case *Settlement_t:
 			if m.Result == results.Unknown {
 				log.Printf("%s: %s: %d: step %d: sub %d: %q\n", fid, unitId, lineNo, stepNo, subStepNo, subStep)
-				return nil, fmt.Errorf("settlement forbidden at beginning of step")
+				return nil, &Error_t{Text: subStep, Column: 1, Err: fmt.Errorf("settlement forbidden at beginning of step")}
 			}
 			m.Report.MergeSettlements(v)
 		case terrain.Terrain_e:
 			if m.Result != results.Unknown { // valid only at the beginning of the step for status line
 				log.Printf("%s: %s: %d: step %d: sub %d: %q\n", fid, unitId, lineNo, stepNo, subStepNo, subStep)
-				return nil, fmt.Errorf("terrain must start status")
+				return nil, &Error_t{Text: subStep, Column: 1, Err: fmt.Errorf("terrain must start status")}
 			}
 			m.Result, m.Still = results.Succeeded, true
 			m.Report.Terrain = v
+		case direction.Direction_e:
+			// a bare direction, like the "N" in "Move SE-GH, \N"
+			return nil, &Error_t{Text: subStep, Column: 1, Err: fmt.Errorf("direction must be followed by terrain")}
 		default:
 			log.Printf("%s: %s: %d: step %d: sub %d: %q\n", fid, unitId, lineNo, stepNo, subStepNo, subStep)
 			log.Printf("error: unexpected type %T\n", v)
 			log.Printf("please report this error\n")
-			panic(fmt.Sprintf("unexpected %T", v))
+			return nil, &Error_t{Text: subStep, Column: 1, Err: fmt.Errorf("unexpected %T in step", v)}
 		}
 	}
 
@@ -935,3 +1022,8 @@ type Location_t struct {
 }
 
 type NoGroupsFound_t struct{}
+
+// PatrolledAndFound_t is returned when a patrol finds other units in the hex.
+type PatrolledAndFound_t struct {
+	Units []UnitId_t
+}
@@ -0,0 +1,522 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/mdhender/ottomap/internal/diagnostics"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Sections_t is the contents of the turn report that isn't movement.
+type Sections_t struct {
+	Units map[UnitId_t]*Section_t
+
+	// Settlements and TransferLog hold the rows of the "Settlements" and
+	// "Transfers" tables. The tables are printed once, at the end of the
+	// report, so they belong to the turn instead of a unit.
+	Settlements []*SettlementEntry_t
+	TransferLog []*TransferEntry_t
+}
+
+// Section_t is the contents of a single unit's section of the turn report.
+// Movement, scout, and status lines are not included; they are returned in
+// the unit's Moves_t.
+type Section_t struct {
+	UnitId      UnitId_t
+	LineNo      int // line number of the section header
+	CurrentHex  string
+	PreviousHex string
+
+	Funds              *Funds_t // only reported for the clan's tribe
+	GoodsTribe         string
+	DesiredCommodities []string
+	Activities         string // text of the "Tribe Activities:" line
+	FinalActivities    string // text of the "Final Activities:" line
+	Transfers          []*Transfer_t
+	Receipts           []*Transfer_t
+	Humans             Humans_t
+	Inventory          []*Inventory_t
+	Skills             []*Skill_t
+	Morale             float64
+	Weight             int
+
+	// Notes holds lines that aren't otherwise recognized,
+	// for example "Int used" or "Locals tell you" lines.
+	Notes []string
+}
+
+// Funds_t is the funds line of the clan's tribe.
+type Funds_t struct {
+	Received float64
+	Cost     float64
+	Credit   float64
+}
+
+// Humans_t is the population of the unit.
+type Humans_t struct {
+	People    int
+	Warriors  int
+	Actives   int
+	Inactives int
+}
+
+// Inventory_t is an item held by the unit.
+// Category is the heading the item was listed under, for example "Animals" or "Raw Materials".
+type Inventory_t struct {
+	Category string
+	Name     string
+	Quantity int
+}
+
+// Transfer_t is a transfer of goods to or from another unit.
+type Transfer_t struct {
+	UnitId UnitId_t // unit receiving or sending the goods
+	Goods  []*Goods_t
+}
+
+// Goods_t is a quantity of goods in a transfer.
+// The name is reported in upper case, for example "WARRIORS" or "PROVS".
+type Goods_t struct {
+	Name     string
+	Quantity int
+}
+
+// Skill_t is a skill and the level the unit has in it.
+type Skill_t struct {
+	Name  string
+	Level int
+}
+
+// SettlementEntry_t is a row from the settlements table.
+type SettlementEntry_t struct {
+	Hex     string
+	Name    string
+	Note    string
+	Type    string
+	Subtype string
+}
+
+// TransferEntry_t is a row from the transfers table.
+// Actual is zero when the report leaves it blank.
+type TransferEntry_t struct {
+	From      UnitId_t
+	To        UnitId_t
+	Item      string
+	Requested int
+	Actual    int
+	Message   string
+}
+
+var (
+	rxActivitiesLine = regexp.MustCompile(`^[A-Za-z]+ Activities:`)
+	rxFundsLine      = regexp.MustCompile(`^Received: \$\s*([0-9.,-]+), Cost: \$\s*([0-9.,-]+)\s+Credit: \$\s*([0-9.,-]+)`)
+	rxMoraleLine     = regexp.MustCompile(`^Morale\s*:\s*([0-9.]+)`)
+	rxQuantityName   = regexp.MustCompile(`^([0-9,]+)\s+(.+)$`)
+	rxScoutPrefix    = regexp.MustCompile(`^Scout \d:`)
+	rxSkillLevel     = regexp.MustCompile(`^(.+?)\s+([0-9]+)$`)
+	rxWeightLine     = regexp.MustCompile(`^Weight:\s*([0-9,]+)`)
+)
+
+// inventoryCategories are the headings used for the unit's inventory.
+var inventoryCategories = map[string]bool{
+	"Animals":        true,
+	"Finished Goods": true,
+	"Minerals":       true,
+	"Raw Materials":  true,
+	"Ships":          true,
+	"War Equipment":  true,
+}
+
+// block_e is the multi-line block being parsed in a section.
+type block_e int
+
+const (
+	noBlock block_e = iota
+	humansBlock
+	inventoryBlock
+	skillsBlock
+	settlementsBlock
+	transfersBlock
+)
+
+// ParseSections returns the contents of every unit section in the turn report,
+// along with the tables at the end of the report.
+// Sections with an invalid or duplicate header are skipped; ParseInput reports those as errors.
+// Lines that can't be parsed are added to the collector as warnings and skipped.
+func ParseSections(fid, tid string, input []byte, dx *diagnostics.Collector_t) *Sections_t {
+	sections := &Sections_t{Units: map[UnitId_t]*Section_t{}}
+	var section *Section_t // current section being parsed
+	var block block_e      // current block in the section
+	var category string    // current inventory category
+	var statusLinePrefix []byte
+
+	warn := func(lineNo int, line []byte, err error) {
+		d := &diagnostics.Diagnostic_t{
+			Severity: diagnostics.Warning,
+			ReportId: fid,
+			TurnId:   tid,
+			Line:     lineNo,
+			Message:  err.Error(),
+			Text:     string(line),
+		}
+		if section != nil {
+			d.UnitId = string(section.UnitId)
+		}
+		dx.Add(d)
+	}
+
+	for n, line := range bytes.Split(input, []byte("\n")) {
+		lineNo := n + 1
+		// bytes.TrimSpace also removes the em spaces the reports use to separate sections
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			// blank lines end every block except the tables
+			if block != settlementsBlock && block != transfersBlock {
+				block = noBlock
+			}
+			continue
+		}
+
+		if unitId, ok := sectionUnitId(line); ok {
+			section, block, statusLinePrefix = nil, noBlock, nil
+			if _, ok := sections.Units[unitId]; ok {
+				continue
+			}
+			location, err := ParseLocationLine(fid, tid, unitId, lineNo, line, false)
+			if err != nil {
+				continue
+			}
+			section = &Section_t{
+				UnitId:      unitId,
+				LineNo:      lineNo,
+				CurrentHex:  location.CurrentHex,
+				PreviousHex: location.PreviousHex,
+			}
+			sections.Units[unitId] = section
+			statusLinePrefix = []byte(fmt.Sprintf("%s Status: ", unitId))
+			continue
+		} else if section == nil {
+			continue
+		}
+
+		// lines that are parsed by ParseInput
+		if bytes.HasPrefix(line, []byte("Current Turn ")) ||
+			rxFleetMovement.Match(line) ||
+			bytes.HasPrefix(line, []byte("Tribe Follows ")) ||
+			bytes.HasPrefix(line, []byte("Tribe Goes to ")) ||
+			bytes.HasPrefix(line, []byte("Tribe Movement: ")) ||
+			rxScoutPrefix.Match(line) ||
+			bytes.HasPrefix(line, statusLinePrefix) {
+			block = noBlock
+			continue
+		}
+
+		text := string(line)
+
+		// headings that start a block
+		if text == "Humans" {
+			block = humansBlock
+			continue
+		} else if inventoryCategories[text] {
+			block, category = inventoryBlock, text
+			continue
+		} else if text == "Skills:" {
+			block = skillsBlock
+			continue
+		} else if text == "Settlements" {
+			block = settlementsBlock
+			continue
+		} else if text == "Transfers" {
+			block = transfersBlock
+			continue
+		}
+
+		switch block {
+		case humansBlock:
+			if err := parseHumans(&section.Humans, text); err != nil {
+				warn(lineNo, line, err)
+			}
+			continue
+		case inventoryBlock:
+			items, err := parseInventory(category, text)
+			if err != nil {
+				warn(lineNo, line, err)
+			}
+			section.Inventory = append(section.Inventory, items...)
+			continue
+		case skillsBlock:
+			skills, err := parseSkills(text)
+			if err != nil {
+				warn(lineNo, line, err)
+			}
+			section.Skills = append(section.Skills, skills...)
+			continue
+		case settlementsBlock:
+			if strings.HasPrefix(text, "Hex Code\t") {
+				continue
+			}
+			fields := strings.Split(text, "\t")
+			for len(fields) < 5 {
+				fields = append(fields, "")
+			}
+			sections.Settlements = append(sections.Settlements, &SettlementEntry_t{
+				Hex:     strings.TrimSpace(fields[0]),
+				Name:    strings.TrimSpace(fields[1]),
+				Note:    strings.TrimSpace(fields[2]),
+				Type:    strings.TrimSpace(fields[3]),
+				Subtype: strings.TrimSpace(fields[4]),
+			})
+			continue
+		case transfersBlock:
+			if strings.HasPrefix(text, "From\t") {
+				continue
+			}
+			entry, err := parseTransferEntry(text)
+			if err != nil {
+				warn(lineNo, line, err)
+				continue
+			}
+			sections.TransferLog = append(sections.TransferLog, entry)
+			continue
+		}
+
+		if match := rxFundsLine.FindStringSubmatch(text); match != nil {
+			if received, err := parseFloat(match[1]); err != nil {
+				warn(lineNo, line, err)
+			} else if cost, err := parseFloat(match[2]); err != nil {
+				warn(lineNo, line, err)
+			} else if credit, err := parseFloat(match[3]); err != nil {
+				warn(lineNo, line, err)
+			} else {
+				section.Funds = &Funds_t{Received: received, Cost: cost, Credit: credit}
+			}
+		} else if s, ok := strings.CutPrefix(text, "Goods Tribe:"); ok {
+			section.GoodsTribe = strings.TrimSpace(s)
+		} else if s, ok := strings.CutPrefix(text, "Desired Commodities:"); ok {
+			section.DesiredCommodities = parseDesiredCommodities(s)
+		} else if s, ok := strings.CutPrefix(text, "Final Activities:"); ok {
+			section.FinalActivities = strings.TrimSpace(s)
+		} else if rxActivitiesLine.MatchString(text) {
+			_, s, _ := strings.Cut(text, ":")
+			section.Activities = strings.TrimSpace(s)
+		} else if s, ok := strings.CutPrefix(text, "Transfer goods to "); ok {
+			transfers, err := parseTransfers(s)
+			if err != nil {
+				warn(lineNo, line, err)
+			}
+			section.Transfers = append(section.Transfers, transfers...)
+		} else if s, ok := strings.CutPrefix(text, "Receive goods from "); ok {
+			receipts, err := parseTransfers(s)
+			if err != nil {
+				warn(lineNo, line, err)
+			}
+			section.Receipts = append(section.Receipts, receipts...)
+		} else if match := rxMoraleLine.FindStringSubmatch(text); match != nil {
+			if morale, err := parseFloat(match[1]); err != nil {
+				warn(lineNo, line, err)
+			} else {
+				section.Morale = morale
+			}
+		} else if match := rxWeightLine.FindStringSubmatch(text); match != nil {
+			if weight, err := parseQuantity(match[1]); err != nil {
+				warn(lineNo, line, err)
+			} else {
+				section.Weight = weight
+			}
+		} else {
+			section.Notes = append(section.Notes, text)
+		}
+	}
+
+	return sections
+}
+
+// sectionUnitId returns the unit id from a section header.
+func sectionUnitId(line []byte) (UnitId_t, bool) {
+	if rxCourierSection.Match(line) || rxElementSection.Match(line) {
+		return UnitId_t(line[8:14]), true
+	} else if rxFleetSection.Match(line) {
+		return UnitId_t(line[6:12]), true
+	} else if rxGarrisonSection.Match(line) {
+		return UnitId_t(line[9:15]), true
+	} else if rxTribeSection.Match(line) {
+		return UnitId_t(line[6:10]), true
+	}
+	return "", false
+}
+
+// parseHumans parses the population lines.
+// They are tab-separated name and count pairs, for example "Warriors \t5740\tActives \t5740".
+func parseHumans(h *Humans_t, text string) error {
+	fields := strings.Split(text, "\t")
+	if len(fields)%2 != 0 {
+		return fmt.Errorf("humans: expected name and count pairs")
+	}
+	for i := 0; i < len(fields); i += 2 {
+		qty, err := parseQuantity(fields[i+1])
+		if err != nil {
+			return fmt.Errorf("humans: %w", err)
+		}
+		switch name := strings.TrimSpace(fields[i]); name {
+		case "People":
+			h.People = qty
+		case "Warriors":
+			h.Warriors = qty
+		case "Actives":
+			h.Actives = qty
+		case "Inactives":
+			h.Inactives = qty
+		default:
+			return fmt.Errorf("humans: unknown name %q", name)
+		}
+	}
+	return nil
+}
+
+// parseInventory parses a line from an inventory category.
+// The line is either "None" or tab-separated name and quantity pairs.
+func parseInventory(category, text string) ([]*Inventory_t, error) {
+	if text == "None" {
+		return nil, nil
+	}
+	fields := strings.Split(text, "\t")
+	if len(fields)%2 != 0 {
+		return nil, fmt.Errorf("%s: expected name and quantity pairs", strings.ToLower(category))
+	}
+	var list []*Inventory_t
+	for i := 0; i < len(fields); i += 2 {
+		qty, err := parseQuantity(fields[i+1])
+		if err != nil {
+			return list, fmt.Errorf("%s: %w", strings.ToLower(category), err)
+		}
+		list = append(list, &Inventory_t{Category: category, Name: strings.TrimSpace(fields[i]), Quantity: qty})
+	}
+	return list, nil
+}
+
+// parseSkills parses the skills line, for example "Adm 4, BnW 1, Bon 1,".
+func parseSkills(text string) ([]*Skill_t, error) {
+	var list []*Skill_t
+	for _, field := range strings.Split(text, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		match := rxSkillLevel.FindStringSubmatch(field)
+		if match == nil {
+			return list, fmt.Errorf("skills: invalid skill %q", field)
+		}
+		level, err := strconv.Atoi(match[2])
+		if err != nil {
+			return list, fmt.Errorf("skills: %q: %w", field, err)
+		}
+		list = append(list, &Skill_t{Name: match[1], Level: level})
+	}
+	return list, nil
+}
+
+// parseDesiredCommodities parses the list of commodities, for example "(1) Coffee, (2) Frankincense".
+// It returns an empty list when the report says "No commodities allocated".
+func parseDesiredCommodities(text string) []string {
+	if strings.TrimSpace(text) == "No commodities allocated" {
+		return nil
+	}
+	var list []string
+	for _, field := range strings.Split(text, ",") {
+		field = strings.TrimSpace(field)
+		if strings.HasPrefix(field, "(") {
+			if _, name, ok := strings.Cut(field, ")"); ok {
+				field = strings.TrimSpace(name)
+			}
+		}
+		if field != "" {
+			list = append(list, field)
+		}
+	}
+	return list
+}
+
+// parseTransfers parses the text after "Transfer goods to" or "Receive goods from".
+// A transfer line can include goods for several units, for example
+// "0991e1: 25 WARRIORS,  50 HORSE, To 1138: 100 WARRIORS".
+func parseTransfers(text string) ([]*Transfer_t, error) {
+	var list []*Transfer_t
+	var transfer *Transfer_t
+	for _, field := range strings.Split(text, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if transfer == nil || strings.HasPrefix(field, "To ") {
+			unitId, goods, ok := strings.Cut(strings.TrimPrefix(field, "To "), ":")
+			if !ok {
+				return list, fmt.Errorf("transfer: missing unit id in %q", field)
+			}
+			transfer = &Transfer_t{UnitId: UnitId_t(strings.TrimSpace(unitId))}
+			list = append(list, transfer)
+			if field = strings.TrimSpace(goods); field == "" {
+				continue
+			}
+		}
+		match := rxQuantityName.FindStringSubmatch(field)
+		if match == nil {
+			return list, fmt.Errorf("transfer: invalid goods %q", field)
+		}
+		qty, err := parseQuantity(match[1])
+		if err != nil {
+			return list, fmt.Errorf("transfer: %w", err)
+		}
+		transfer.Goods = append(transfer.Goods, &Goods_t{Name: strings.TrimSpace(match[2]), Quantity: qty})
+	}
+	return list, nil
+}
+
+// parseTransferEntry parses a row from the transfers table.
+// The columns are From, To, Item, Requested, Actual, and Message.
+func parseTransferEntry(text string) (*TransferEntry_t, error) {
+	fields := strings.Split(text, "\t")
+	if len(fields) < 4 {
+		return nil, fmt.Errorf("transfers: expected at least 4 columns, got %d", len(fields))
+	}
+	for len(fields) < 6 {
+		fields = append(fields, "")
+	}
+	entry := &TransferEntry_t{
+		From:    UnitId_t(strings.TrimSpace(fields[0])),
+		To:      UnitId_t(strings.TrimSpace(fields[1])),
+		Item:    strings.TrimSpace(fields[2]),
+		Message: strings.TrimSpace(fields[5]),
+	}
+	var err error
+	if entry.Requested, err = parseQuantity(fields[3]); err != nil {
+		return nil, fmt.Errorf("transfers: requested: %w", err)
+	}
+	if actual := strings.TrimSpace(fields[4]); actual != "" {
+		if entry.Actual, err = parseQuantity(actual); err != nil {
+			return nil, fmt.Errorf("transfers: actual: %w", err)
+		}
+	}
+	return entry, nil
+}
+
+// parseQuantity parses an integer that may contain commas, for example "659,331".
+func parseQuantity(s string) (int, error) {
+	n, err := strconv.Atoi(strings.ReplaceAll(strings.TrimSpace(s), ",", ""))
+	if err != nil {
+		return 0, fmt.Errorf("invalid quantity %q", strings.TrimSpace(s))
+	}
+	return n, nil
+}
+
+// parseFloat parses a number that may contain commas, for example "1,191.1".
+func parseFloat(s string) (float64, error) {
+	n, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(s), ",", ""), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", strings.TrimSpace(s))
+	}
+	return n, nil
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package parser_test
+
+import (
+	"github.com/go-test/deep"
+	"github.com/mdhender/ottomap/internal/diagnostics"
+	"github.com/mdhender/ottomap/internal/parser"
+	"strings"
+	"testing"
+)
+
+func TestParseSections(t *testing.T) {
+	// the sections are taken from the reports in data/input
+	for _, tc := range []struct {
+		id          string
+		lines       []string
+		units       []*parser.Section_t
+		settlements []*parser.SettlementEntry_t
+		transferLog []*parser.TransferEntry_t
+		warnings    int
+	}{
+		{id: "0899-12.0991",
+			lines: []string{
+				"Tribe 0991, , Current Hex = MH 0714, (Previous Hex = MH 0714)",
+				"Current Turn 899-12 (#0), Winter, FINE\tNext Turn 900-01 (#1), 29/10/2023",
+				"Received: $0, Cost: $ 0\tCredit: $ 200",
+				"Goods Tribe: No GT",
+				"",
+				"Desired Commodities: No commodities allocated",
+				"",
+				"0991 Status: PRAIRIE, 0991",
+				"",
+				"Humans",
+				"People\t17670",
+				"Warriors \t5890\tActives \t5890\tInactives \t5890",
+				"",
+				"Animals",
+				"Cattle \t500\tGoat \t3700\tHorse \t400",
+				"Ships",
+				"None",
+				"",
+				"Skills:",
+				"Adm 6, BnW 1, Bon 1,",
+				"",
+				"Morale : \t1",
+				"",
+				"Weight: 531,670",
+				"",
+				" ",
+				"Settlements",
+				"Hex Code\tName\tNote\tType\tSubtype",
+				"",
+			},
+			units: []*parser.Section_t{
+				{UnitId: "0991", LineNo: 1, CurrentHex: "MH 0714", PreviousHex: "MH 0714",
+					Funds:      &parser.Funds_t{Received: 0, Cost: 0, Credit: 200},
+					GoodsTribe: "No GT",
+					Humans:     parser.Humans_t{People: 17670, Warriors: 5890, Actives: 5890, Inactives: 5890},
+					Inventory: []*parser.Inventory_t{
+						{Category: "Animals", Name: "Cattle", Quantity: 500},
+						{Category: "Animals", Name: "Goat", Quantity: 3700},
+						{Category: "Animals", Name: "Horse", Quantity: 400},
+					},
+					Skills: []*parser.Skill_t{{Name: "Adm", Level: 6}, {Name: "BnW", Level: 1}, {Name: "Bon", Level: 1}},
+					Morale: 1,
+					Weight: 531670,
+				},
+			},
+		},
+		{id: "0900-01.0991",
+			lines: []string{
+				"Tribe 0991, , Current Hex = ## 1113, (Previous Hex = ## 0714)",
+				"Current Turn 900-01 (#1), Spring, FINE\tNext Turn 900-02 (#2), 12/11/2023",
+				"Received: $0, Cost: $ 8.9\tCredit: $ 191.1",
+				"Goods Tribe: No GT",
+				"",
+				"Desired Commodities: (1) Coffee, (2) Frankincense",
+				"",
+				"Int used 01 900.",
+				"",
+				"Locals tell you there is Iron Ore at 0902 on the mapsheet you are on.",
+				"Tribe Activities: 40 people Skin\\gut\\bone 60 Goat,  11113  people hunted 30672 provs",
+				"",
+				"Final Activities:",
+				"",
+				"Transfer goods to 0991e1: 25 WARRIORS,  50 HORSE, To 1138: 100 WARRIORS,  600 PROVS,",
+				"",
+				"Tribe Movement: Move SE-GH, \\NE-GHP, River SE\\NE-PR, River S\\NE-PPR, \\Not enough M.P's to move to NE into ROCKY HILLS",
+				"",
+				"0991 Status: PRAIRIE, 0991",
+				"",
+				" ",
+				"Element 0991e1, , Current Hex = ## 1013, (Previous Hex = ## 0714)",
+				"Current Turn 900-01 (#1), Spring, FINE",
+				"Goods Tribe: No GT",
+				"",
+				"Receive goods from 0991: 25 WARRIORS,  50 HORSE,",
+				"",
+				"Scout 1:Scout SE-RH, River SE S SW\\ no Ford on River to SE of HEX, nothing of interest found",
+				"",
+				"Humans",
+				"People\t50",
+				"Warriors \t25\tActives \t25\tInactives \t0",
+				"",
+				"War Equipment",
+				"None",
+				"",
+				"Skills:",
+				"",
+				"Morale : \t1.01",
+				"",
+				"Weight: 1,340",
+				"",
+				"Transfers",
+				"From\tTo\tItem\tRequested\tActual\tMessage",
+				"0991\t0991e1\tWARRIORS\t25\t \tTransfer in same hex",
+				"0991\t0991e1\tHORSE\t50\t \tTransfer in same hex",
+				"",
+				" ",
+				"Settlements",
+				"Hex Code\tName\tNote\tType\tSubtype",
+				"",
+			},
+			units: []*parser.Section_t{
+				{UnitId: "0991", LineNo: 1, CurrentHex: "## 1113", PreviousHex: "## 0714",
+					Funds:              &parser.Funds_t{Received: 0, Cost: 8.9, Credit: 191.1},
+					GoodsTribe:         "No GT",
+					DesiredCommodities: []string{"Coffee", "Frankincense"},
+					Activities:         "40 people Skin\\gut\\bone 60 Goat,  11113  people hunted 30672 provs",
+					Transfers: []*parser.Transfer_t{
+						{UnitId: "0991e1", Goods: []*parser.Goods_t{{Name: "WARRIORS", Quantity: 25}, {Name: "HORSE", Quantity: 50}}},
+						{UnitId: "1138", Goods: []*parser.Goods_t{{Name: "WARRIORS", Quantity: 100}, {Name: "PROVS", Quantity: 600}}},
+					},
+					Notes: []string{"Int used 01 900.", "Locals tell you there is Iron Ore at 0902 on the mapsheet you are on."},
+				},
+				{UnitId: "0991e1", LineNo: 22, CurrentHex: "## 1013", PreviousHex: "## 0714",
+					GoodsTribe: "No GT",
+					Receipts: []*parser.Transfer_t{
+						{UnitId: "0991", Goods: []*parser.Goods_t{{Name: "WARRIORS", Quantity: 25}, {Name: "HORSE", Quantity: 50}}},
+					},
+					Humans: parser.Humans_t{People: 50, Warriors: 25, Actives: 25},
+					Morale: 1.01,
+					Weight: 1340,
+				},
+			},
+			transferLog: []*parser.TransferEntry_t{
+				{From: "0991", To: "0991e1", Item: "WARRIORS", Requested: 25, Message: "Transfer in same hex"},
+				{From: "0991", To: "0991e1", Item: "HORSE", Requested: 50, Message: "Transfer in same hex"},
+			},
+		},
+		{id: "0900-01.1991",
+			lines: []string{
+				"Tribe 1991, , Current Hex = ## 0616, (Previous Hex = ## 0714)",
+				"Humans",
+				"People\tmany",
+				"Warriors \t100\tActives \t100\tInactives \t100",
+				"",
+				"Skills:",
+				"Adm 2, Eng, ShB 4,",
+			},
+			units: []*parser.Section_t{
+				{UnitId: "1991", LineNo: 1, CurrentHex: "## 0616", PreviousHex: "## 0714",
+					Humans: parser.Humans_t{Warriors: 100, Actives: 100, Inactives: 100},
+					Skills: []*parser.Skill_t{{Name: "Adm", Level: 2}},
+				},
+			},
+			warnings: 2,
+		},
+	} {
+		dx := diagnostics.New()
+		sections := parser.ParseSections(tc.id, tc.id[:7], []byte(strings.Join(tc.lines, "\n")), dx)
+		if len(sections.Units) != len(tc.units) {
+			t.Errorf("id %q: units: want %d, got %d\n", tc.id, len(tc.units), len(sections.Units))
+		}
+		for _, want := range tc.units {
+			got, ok := sections.Units[want.UnitId]
+			if !ok {
+				t.Errorf("id %q: unit %q: missing section\n", tc.id, want.UnitId)
+				continue
+			}
+			for _, d := range deep.Equal(want, got) {
+				t.Errorf("id %q: unit %q: %s\n", tc.id, want.UnitId, d)
+			}
+		}
+		for _, d := range deep.Equal(tc.settlements, sections.Settlements) {
+			t.Errorf("id %q: settlements: %s\n", tc.id, d)
+		}
+		for _, d := range deep.Equal(tc.transferLog, sections.TransferLog) {
+			t.Errorf("id %q: transfer log: %s\n", tc.id, d)
+		}
+		if dx.Warnings() != tc.warnings {
+			t.Errorf("id %q: warnings: want %d, got %d\n", tc.id, tc.warnings, dx.Warnings())
+		}
+		for _, d := range dx.Diagnostics {
+			if d.UnitId != "1991" || d.Line == 0 {
+				t.Errorf("id %q: warning: want unit and line, got %s\n", tc.id, d)
+			}
+		}
+	}
+}
+
+func TestParseSectionsDesiredCommodities(t *testing.T) {
+	for _, tc := range []struct {
+		line string
+		want []string
+	}{
+		{line: "Desired Commodities: No commodities allocated"},
+		{line: "Desired Commodities: (1) Coffee, (2) Frankincense", want: []string{"Coffee", "Frankincense"}},
+		{line: "Desired Commodities: (1) Coffee", want: []string{"Coffee"}},
+	} {
+		input := "Tribe 0991, , Current Hex = MH 0714, (Previous Hex = MH 0714)\n" + tc.line + "\n"
+		sections := parser.ParseSections("0899-12.0991", "0899-12", []byte(input), diagnostics.New())
+		got := sections.Units["0991"].DesiredCommodities
+		for _, d := range deep.Equal(tc.want, got) {
+			t.Errorf("%q: %s\n", tc.line, d)
+		}
+	}
+}
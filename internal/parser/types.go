@@ -12,6 +12,8 @@ import (
 	"github.com/mdhender/ottomap/internal/resources"
 	"github.com/mdhender/ottomap/internal/results"
 	"github.com/mdhender/ottomap/internal/terrain"
+	"github.com/mdhender/ottomap/internal/units"
+	"github.com/mdhender/ottomap/internal/winds"
 	"sort"
 	"strings"
 )
@@ -24,11 +26,33 @@ type Turn_t struct {
 	Year  int
 	Month int
 
+	// taken from the "Current Turn" lines in the report.
+	// the next turn and report date are usually only on the first line.
+	Season     string // for example, "Winter"
+	Weather    string // for example, "FINE"
+	NextTurnId string // turn id from the "Next Turn" part of the line
+	ReportDate string // date printed after the next turn, as yyyy-mm-dd
+
 	// UnitMoves holds the units that moved in this turn
 	UnitMoves   map[UnitId_t]*Moves_t
-	SortedMoves []*Moves_t
+	SortedMoves []*Moves_t `json:"-"` // rebuilt from UnitMoves
+
+	// Sections holds the rest of the report: inventory, population, skills, and transfers
+	// for each unit, and the settlements and transfers tables for the turn
+	Sections *Sections_t
+
+	Next, Prev *Turn_t `json:"-"` // linked after the turns are consolidated
+}
 
-	Next, Prev *Turn_t
+// ExpectedNextTurnId returns the id of the turn that should follow this one.
+// It is the next turn from the report if we have it; otherwise, it is the following month.
+func (t *Turn_t) ExpectedNextTurnId() string {
+	if t.NextTurnId != "" {
+		return t.NextTurnId
+	} else if t.Month == 12 {
+		return fmt.Sprintf("%04d-%02d", t.Year+1, 1)
+	}
+	return fmt.Sprintf("%04d-%02d", t.Year, t.Month+1)
 }
 
 func (t *Turn_t) FromMayBeObscured() bool {
@@ -83,8 +107,9 @@ func (t *Turn_t) TopoSortMoves() {
 // Moves_t represents the results for a unit that moves and reports in a turn.
 // There will be one instance of this struct for each turn the unit moves in.
 type Moves_t struct {
-	TurnId string
-	Id     UnitId_t // unit that is moving
+	TurnId   string
+	ReportId string   // id of the report file the unit was parsed from
+	Id       UnitId_t // unit that is moving
 
 	// all the moves made this turn
 	Moves   []*Move_t
@@ -104,6 +129,10 @@ type Moves_t struct {
 	// It might be the same as the FromHex if the unit stays in place or fails to move.
 	ToHex string
 
+	// StartHex is the hex the walk started the unit in. It is set by the walk
+	// and may not match FromHex (for example, when FromHex is "N/A").
+	StartHex string
+
 	// Location is the tile the unit ends the move in
 	Location coords.Map
 }
@@ -148,10 +177,16 @@ type Report_t struct {
 	// transient items in this hex
 	Encounters  []*Encounter_t // other units in the hex
 	Items       []*FoundItem_t
+	Longhouses  []*Longhouse_t
+	Obstacles   []*Obstacle_t // borders that stopped the unit
+	Patrols     []*Patrol_t   // outcomes of scouting parties in this hex
 	Resources   []resources.Resource_e
 	Settlements []*Settlement_t
 	FarHorizons []*FarHorizon_t
 
+	// Wind is set for fleet movement; it is the wind the fleet sailed with this turn
+	Wind *Wind_t
+
 	WasVisited bool // set to true if the location was visited by any unit
 	WasScouted bool // set to true if the location was visited by a scouting party or a unit ended the turn here
 }
@@ -197,7 +232,7 @@ func (r *Report_t) mergeItems(list []*FoundItem_t, f *FoundItem_t) []*FoundItem_
 		return []*FoundItem_t{f}
 	}
 	for _, l := range list {
-		if l.Item != f.Item {
+		if l.Item == f.Item {
 			l.Quantity += f.Quantity
 			return list
 		}
@@ -205,6 +240,48 @@ func (r *Report_t) mergeItems(list []*FoundItem_t, f *FoundItem_t) []*FoundItem_
 	return append(list, f)
 }
 
+// MergeLonghouses adds a new longhouse to the list if it's not already in the list
+func (r *Report_t) MergeLonghouses(lh *Longhouse_t) bool {
+	if lh == nil {
+		return false
+	}
+	for _, l := range r.Longhouses {
+		if l.Id == lh.Id {
+			return false
+		}
+	}
+	r.Longhouses = append(r.Longhouses, lh)
+	return true
+}
+
+// MergeObstacles adds a new obstacle to the list if it's not already in the list
+func (r *Report_t) MergeObstacles(o *Obstacle_t) bool {
+	if o == nil {
+		return false
+	}
+	for _, l := range r.Obstacles {
+		if l.Equals(o) {
+			return false
+		}
+	}
+	r.Obstacles = append(r.Obstacles, o)
+	return true
+}
+
+// MergePatrols adds a new patrol outcome to the list if it's not already in the list
+func (r *Report_t) MergePatrols(p *Patrol_t) bool {
+	if p == nil {
+		return false
+	}
+	for _, l := range r.Patrols {
+		if l.Equals(p) {
+			return false
+		}
+	}
+	r.Patrols = append(r.Patrols, p)
+	return true
+}
+
 // MergeResources adds a new resource to the list if it's not already in the list
 func (r *Report_t) MergeResources(rs resources.Resource_e) bool {
 	if rs == resources.None {
@@ -278,13 +355,18 @@ func (e *Exhausted_t) String() string {
 	return fmt.Sprintf("x(%s-%s)", e.Direction, e.Terrain)
 }
 
+// FarHorizon_t is land or water sighted from a fleet's crow's-nest.
+// Point is the direction from the fleet to the hex that was sighted.
 type FarHorizon_t struct {
+	TurnId  string   // turn the sighting was made
+	UnitId  UnitId_t // fleet that made the sighting
 	Point   compass.Point_e
-	Terrain terrain.Terrain_e
+	Terrain terrain.Terrain_e // only ever UnknownLand or UnknownWater
 }
 
 // FoundItem_t represents items discovered by Scouts as they pass through a hex.
 type FoundItem_t struct {
+	TurnId   string // turn the items were found
 	Quantity int
 	Item     items.Item_e
 }
@@ -296,11 +378,127 @@ func (f *FoundItem_t) String() string {
 	return fmt.Sprintf("found(%d-%s)", f.Quantity, f.Item)
 }
 
+// Longhouse_t represents a longhouse seen by a unit.
 type Longhouse_t struct {
+	TurnId   string // turn the longhouse was seen
 	Id       string
 	Capacity int
 }
 
+// Obstacle_t is a border that stopped a unit from moving out of a hex.
+// Result is Blocked for edges (for example, a river without a ford),
+// Prohibited for terrain the unit can't enter (for example, ocean),
+// and ExhaustedMovementPoints when the unit didn't have the movement points to enter the terrain.
+type Obstacle_t struct {
+	TurnId    string
+	UnitType  units.Type_e // type of the unit that was stopped
+	Scouts    bool         // true if the unit was a scouting party
+	Direction direction.Direction_e
+	Result    results.Result_e
+	Edge      edges.Edge_e      // set when blocked by an edge
+	Terrain   terrain.Terrain_e // set when prohibited or exhausted
+}
+
+// Equals returns true if the obstacles are the same, ignoring the turn.
+func (o *Obstacle_t) Equals(p *Obstacle_t) bool {
+	return o.UnitType == p.UnitType && o.Scouts == p.Scouts && o.Direction == p.Direction && o.Result == p.Result && o.Edge == p.Edge && o.Terrain == p.Terrain
+}
+
+// Mover returns the name of the type of unit that was stopped.
+func (o *Obstacle_t) Mover() string {
+	if o.Scouts {
+		return "Scouts"
+	}
+	return o.UnitType.String()
+}
+
+func (o *Obstacle_t) String() string {
+	if o == nil {
+		return ""
+	}
+	switch o.Result {
+	case results.Blocked:
+		return fmt.Sprintf("%s: %s: blocked by %s to %s", o.TurnId, o.Mover(), o.Edge, o.Direction)
+	case results.Prohibited:
+		return fmt.Sprintf("%s: %s: can't move on %s to %s", o.TurnId, o.Mover(), o.Terrain, o.Direction)
+	case results.ExhaustedMovementPoints:
+		return fmt.Sprintf("%s: %s: not enough MPs to move %s into %s", o.TurnId, o.Mover(), o.Direction, o.Terrain)
+	}
+	return fmt.Sprintf("%s: %s: %s to %s", o.TurnId, o.Mover(), o.Result, o.Direction)
+}
+
+// Patrol_t is the outcome of a scouting party in a hex.
+// A patrol that was not lost and found no units means the hex was clear.
+type Patrol_t struct {
+	TurnId  string
+	UnitId  UnitId_t   // unit that sent out the scouts
+	ScoutNo int        // zero if the outcome was not reported on a scout line
+	Lost    bool       // true if the scouts did not return
+	Found   []UnitId_t // units found by the patrol
+}
+
+// Equals returns true if the patrols have the same outcome.
+// The scout number is ignored since every scout from a unit can patrol the same hex.
+func (p *Patrol_t) Equals(o *Patrol_t) bool {
+	if p.TurnId != o.TurnId || p.UnitId != o.UnitId || p.Lost != o.Lost || len(p.Found) != len(o.Found) {
+		return false
+	}
+	for i := range p.Found {
+		if p.Found[i] != o.Found[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Patrol_t) String() string {
+	if p == nil {
+		return ""
+	} else if p.Lost {
+		return fmt.Sprintf("%s: %s: lost", p.TurnId, p.UnitId)
+	} else if len(p.Found) == 0 {
+		return fmt.Sprintf("%s: %s: clear", p.TurnId, p.UnitId)
+	}
+	var found []string
+	for _, id := range p.Found {
+		found = append(found, string(id))
+	}
+	return fmt.Sprintf("%s: %s: found %s", p.TurnId, p.UnitId, strings.Join(found, " "))
+}
+
+// Sighting_t is a crow's-nest sighting recorded on the hex that was sighted.
+// The sighting only tells us if the hex is land or water, so it is a low-confidence
+// report that is replaced when a unit visits the hex or sees it from an adjacent hex.
+type Sighting_t struct {
+	TurnId  string
+	UnitId  UnitId_t          // fleet that made the sighting
+	Terrain terrain.Terrain_e // UnknownLand or UnknownWater
+}
+
+func (s *Sighting_t) String() string {
+	if s == nil {
+		return ""
+	} else if s.Terrain == terrain.UnknownWater {
+		return fmt.Sprintf("%s: %s: sighted water", s.TurnId, s.UnitId)
+	}
+	return fmt.Sprintf("%s: %s: sighted land", s.TurnId, s.UnitId)
+}
+
+// Wind_t is the wind reported on a fleet movement line.
+type Wind_t struct {
+	TurnId   string
+	UnitId   UnitId_t // fleet that reported the wind
+	Strength winds.Strength_e
+	From     direction.Direction_e
+}
+
+func (w *Wind_t) String() string {
+	if w == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s: %s: %s %s", w.TurnId, w.UnitId, w.Strength, w.From)
+}
+
 // MissingEdge_t is returned for "No River Adjacent to Hex"
 type MissingEdge_t struct {
 	Direction direction.Direction_e
@@ -383,3 +581,26 @@ func (u UnitId_t) Parent() UnitId_t {
 func (u UnitId_t) String() string {
 	return string(u)
 }
+
+// Type returns the type of the unit based on the unit id.
+// The clan is the tribe with an id that starts with a zero.
+func (u UnitId_t) Type() units.Type_e {
+	if len(u) == 4 {
+		if u[0] == '0' {
+			return units.Clan
+		}
+		return units.Tribe
+	} else if len(u) == 6 {
+		switch u[4] {
+		case 'c':
+			return units.Courier
+		case 'e':
+			return units.Element
+		case 'f':
+			return units.Fleet
+		case 'g':
+			return units.Garrison
+		}
+	}
+	return units.Unknown
+}
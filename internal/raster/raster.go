@@ -0,0 +1,223 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+// Package raster draws the map as a PNG image using only the standard library.
+//
+// The hexes use the same geometry as the Worldographer map and are scaled
+// so that each hex is the requested number of pixels wide.
+package raster
+
+import (
+	"fmt"
+	"github.com/mdhender/ottomap/internal/direction"
+	"github.com/mdhender/ottomap/internal/terrain"
+	"github.com/mdhender/ottomap/internal/wxx"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"log"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultPixelsPerHex is used when the pixels per hex isn't set.
+	DefaultPixelsPerHex = 48
+	// hexWidth is the width of a hex in the Worldographer geometry.
+	hexWidth = 300
+)
+
+// canvas_t maps the Worldographer geometry to the pixels of the image.
+type canvas_t struct {
+	img        *image.RGBA
+	scale      float64 // pixels per unit of the geometry
+	minX, minY float64 // top left of the geometry
+}
+
+// point converts a point in the geometry to a pixel.
+func (c *canvas_t) point(p wxx.Point) (float64, float64) {
+	return (p.X - c.minX) * c.scale, (p.Y - c.minY) * c.scale
+}
+
+// width converts a width in the geometry to pixels, with a minimum of one pixel.
+func (c *canvas_t) width(w float64) int {
+	return max(1, int(math.Round(w*c.scale)))
+}
+
+// Create writes the hexes and trails to a PNG file. Each hex is
+// cfg.PixelsPerHex pixels wide; if it is zero, DefaultPixelsPerHex is used.
+func Create(path string, hexes []*wxx.Hex, trails []*wxx.Trail, cfg wxx.RenderConfig) error {
+	pixelsPerHex := cfg.PixelsPerHex
+	if len(hexes) == 0 {
+		return fmt.Errorf("png: create: no hexes")
+	} else if pixelsPerHex == 0 {
+		pixelsPerHex = DefaultPixelsPerHex
+	} else if pixelsPerHex < 0 {
+		return fmt.Errorf("png: create: pixels per hex must be positive")
+	}
+	log.Printf("png: create: %d hexes at %d pixels per hex\n", len(hexes), pixelsPerHex)
+
+	// find the bounds of the drawing and the offset from the true location to the render location
+	topLeft, bottomRight, renderOffset := wxx.Bounds(hexes)
+	minX, minY, maxX, maxY := topLeft.X, topLeft.Y, bottomRight.X, bottomRight.Y
+
+	c := &canvas_t{scale: float64(pixelsPerHex) / hexWidth, minX: minX, minY: minY}
+	c.img = image.NewRGBA(image.Rect(0, 0, int(math.Ceil((maxX-minX)*c.scale)), int(math.Ceil((maxY-minY)*c.scale))))
+	draw.Draw(c.img, c.img.Bounds(), image.NewUniform(color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}), image.Point{}, draw.Src)
+
+	// layers are drawn from the bottom up, so terrain first and labels last
+	grid := color.RGBA{R: 0x80, G: 0x80, B: 0x80, A: 0xff}
+	for _, hex := range hexes {
+		points := wxx.HexPoints(hex.RenderAt.Column, hex.RenderAt.Row)
+		c.fillHex(points, terrainColor(hex.Terrain))
+		for n := 1; n <= 6; n++ {
+			c.line(points[n], points[n%6+1], grid, 1)
+		}
+	}
+
+	river := color.RGBA{R: 0x99, G: 0xcc, B: 0xff, A: 0xff}
+	pass := color.RGBA{R: 0xff, G: 0xff, B: 0x00, A: 0xff}
+	road := color.RGBA{R: 0xb3, G: 0xb3, B: 0xb3, A: 0xff}
+	for _, hex := range hexes {
+		points := wxx.HexPoints(hex.RenderAt.Column, hex.RenderAt.Row)
+		fords := map[direction.Direction_e]bool{}
+		for _, dir := range hex.Features.Edges.Ford {
+			// a ford is a river with a gap in the middle
+			fords[dir] = true
+			from, to := wxx.EdgeVertices(dir, points)
+			ford := wxx.EdgeCenter(dir, points)
+			c.line(from, wxx.Midpoint(from, ford), river, c.width(20))
+			c.line(wxx.Midpoint(to, ford), to, river, c.width(20))
+		}
+		for _, dir := range hex.Features.Edges.River {
+			if !fords[dir] {
+				from, to := wxx.EdgeVertices(dir, points)
+				c.line(from, to, river, c.width(20))
+			}
+		}
+		for _, dir := range hex.Features.Edges.Pass {
+			end := wxx.EdgeCenter(dir, points)
+			c.line(wxx.Midpoint(wxx.Midpoint(points[0], end), end), end, pass, c.width(27))
+		}
+		for _, dir := range hex.Features.Edges.StoneRoad {
+			end := wxx.EdgeCenter(dir, points)
+			c.line(wxx.Midpoint(wxx.Midpoint(points[0], end), end), end, road, c.width(15))
+		}
+	}
+
+	for _, trail := range trails {
+		points := wxx.TrailPoints(trail, renderOffset)
+		r, g, b := trail.RGB()
+		tc := color.RGBA{R: uint8(r * 255), G: uint8(g * 255), B: uint8(b * 255), A: 0xff}
+		for n := 1; n < len(points); n++ {
+			c.line(points[n-1], points[n], tc, c.width(9))
+		}
+	}
+
+	// the font is 7 pixels high, so scale it to about a fifth of the hex
+	textScale := max(1, pixelsPerHex/35)
+	black := color.RGBA{A: 0xff}
+	for _, hex := range hexes {
+		points := wxx.HexPoints(hex.RenderAt.Column, hex.RenderAt.Row)
+		if hex.Terrain != terrain.Blank && (cfg.Show.Grid.Coords || cfg.Show.Grid.Numbers) {
+			label := hex.Location.GridString()
+			if !cfg.Show.Grid.Coords {
+				label = label[3:]
+			}
+			x, y := c.point(wxx.Point{X: points[0].X, Y: points[0].Y - 105})
+			drawText(c.img, label, int(x), int(y), max(1, textScale/2), black)
+		}
+		for _, s := range hex.Features.Settlements {
+			if s == nil || s.Name == "" || strings.HasPrefix(s.Name, "_") {
+				continue
+			}
+			x, y := c.point(points[0])
+			size := max(2, textScale*2)
+			fillRect(c.img, int(x)-size, int(y)-size, int(x)+size, int(y)+size, black)
+			at := wxx.EdgeCenter(direction.South, points)
+			x, y = c.point(wxx.Point{X: at.X, Y: at.Y - 40})
+			drawText(c.img, strings.Trim(s.Name, "_"), int(x), int(y), textScale, black)
+		}
+	}
+
+	fd, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(fd, c.img); err != nil {
+		_ = fd.Close()
+		return err
+	} else if err := fd.Close(); err != nil {
+		return err
+	}
+	log.Printf("png: created %s\n", path)
+	return nil
+}
+
+// fillHex fills the hex with the color. The hex is convex, so each row
+// of pixels crosses it once.
+func (c *canvas_t) fillHex(points [7]wxx.Point, fill color.RGBA) {
+	var xs, ys [6]float64
+	top, bottom := math.MaxFloat64, -math.MaxFloat64
+	for n := 0; n < 6; n++ {
+		xs[n], ys[n] = c.point(points[n+1])
+		top, bottom = math.Min(top, ys[n]), math.Max(bottom, ys[n])
+	}
+	for y := int(math.Floor(top)); y <= int(math.Ceil(bottom)); y++ {
+		// use the center of the pixel to find where the row crosses the edges
+		py := float64(y) + 0.5
+		left, right := math.MaxFloat64, -math.MaxFloat64
+		for n := 0; n < 6; n++ {
+			x1, y1, x2, y2 := xs[n], ys[n], xs[(n+1)%6], ys[(n+1)%6]
+			if y1 == y2 || py < math.Min(y1, y2) || py > math.Max(y1, y2) {
+				continue
+			}
+			x := x1 + (py-y1)*(x2-x1)/(y2-y1)
+			left, right = math.Min(left, x), math.Max(right, x)
+		}
+		if left <= right {
+			fillRect(c.img, int(math.Round(left)), y, int(math.Round(right)), y+1, fill)
+		}
+	}
+}
+
+// line draws a line that is width pixels wide between the two points.
+func (c *canvas_t) line(from, to wxx.Point, lc color.RGBA, width int) {
+	x1, y1 := c.point(from)
+	x2, y2 := c.point(to)
+	steps := int(math.Ceil(math.Max(math.Abs(x2-x1), math.Abs(y2-y1))))
+	half := width / 2
+	for n := 0; n <= steps; n++ {
+		t := 0.0
+		if steps != 0 {
+			t = float64(n) / float64(steps)
+		}
+		x, y := int(math.Round(x1+t*(x2-x1))), int(math.Round(y1+t*(y2-y1)))
+		fillRect(c.img, x-half, y-half, x-half+width, y-half+width, lc)
+	}
+}
+
+// fillRect fills the rectangle from (x0, y0) up to but not including (x1, y1).
+func fillRect(img *image.RGBA, x0, y0, x1, y1 int, fill color.RGBA) {
+	r := image.Rect(x0, y0, x1, y1).Intersect(img.Bounds())
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			img.SetRGBA(x, y, fill)
+		}
+	}
+}
+
+// terrainColor returns the fill color for the terrain.
+func terrainColor(t terrain.Terrain_e) color.RGBA {
+	hex, ok := terrain.TileColors[t]
+	if !ok {
+		hex = terrain.TileColors[terrain.Blank]
+	}
+	rgb, err := strconv.ParseUint(strings.TrimPrefix(hex, "#"), 16, 32)
+	if err != nil {
+		panic(fmt.Sprintf("assert(terrain color %q is valid)", hex))
+	}
+	return color.RGBA{R: uint8(rgb >> 16), G: uint8(rgb >> 8), B: uint8(rgb), A: 0xff}
+}
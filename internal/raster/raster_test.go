@@ -0,0 +1,59 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package raster_test
+
+import (
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/direction"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/raster"
+	"github.com/mdhender/ottomap/internal/terrain"
+	"github.com/mdhender/ottomap/internal/wxx"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCreate(t *testing.T) {
+	hex := &wxx.Hex{
+		Location: coords.Map{Column: 10, Row: 10},
+		RenderAt: coords.Map{Column: 4, Row: 4},
+		Terrain:  terrain.Prairie,
+	}
+	hex.Features.Edges.River = []direction.Direction_e{direction.North}
+	hex.Features.Settlements = []*parser.Settlement_t{{Name: "Fish & Chips"}}
+
+	path := filepath.Join(t.TempDir(), "0991.png")
+	if err := raster.Create(path, []*wxx.Hex{hex}, nil, wxx.RenderConfig{PixelsPerHex: 60}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	fd, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer fd.Close()
+	img, err := png.Decode(fd)
+	if err != nil {
+		t.Fatalf("png: %v", err)
+	}
+
+	// the hex is 300 units wide with a margin of 150 units on each side
+	if got := img.Bounds().Dx(); got != 120 {
+		t.Errorf("width: want 120, got %d", got)
+	}
+	// the settlement is drawn as a black square in the center of the hex
+	center := img.Bounds().Max.Div(2)
+	if r, g, b, _ := img.At(center.X, center.Y).RGBA(); r != 0 || g != 0 || b != 0 {
+		t.Errorf("center: want black, got %v", img.At(center.X, center.Y))
+	}
+	// the corners are outside the hex and are left white
+	if got := color.RGBAModel.Convert(img.At(0, 0)); got != (color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}) {
+		t.Errorf("corner: want white, got %v", got)
+	}
+
+	if err := raster.Create(path, nil, nil, wxx.RenderConfig{}); err == nil {
+		t.Errorf("no hexes: want error, got nil")
+	}
+}
@@ -44,6 +44,7 @@ var (
 		"Blocked":       Blocked,
 		"Exhausted MPs": ExhaustedMovementPoints,
 		"Failed":        Failed,
+		"Followed":      Followed,
 		"Follows":       Followed,
 		"N/A":           StayedInPlace,
 		"Prohibited":    Prohibited,
@@ -0,0 +1,230 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+// Package route finds the cheapest known path between two tiles on the world map.
+//
+// Only tiles that we have terrain for are used. The path never leaves the
+// explored part of the map, so a route that exists in the game may not be
+// found here if the tiles between the two locations haven't been mapped yet.
+package route
+
+import (
+	"container/heap"
+	"fmt"
+	"github.com/mdhender/ottomap/cerrs"
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/direction"
+	"github.com/mdhender/ottomap/internal/edges"
+	"github.com/mdhender/ottomap/internal/results"
+	"github.com/mdhender/ottomap/internal/terrain"
+	"github.com/mdhender/ottomap/internal/tiles"
+	"strings"
+)
+
+const (
+	ErrNoRoute     = cerrs.Error("no known route")
+	ErrUnknownTile = cerrs.Error("tile has not been explored")
+)
+
+// Costs is the number of movement points needed to enter a tile of the terrain.
+// Terrain that is missing from the map can't be entered on foot.
+// The costs are for a tribe moving over land; they are close enough to the
+// game's costs to rank routes but should not be used to plan the last point.
+var Costs = map[terrain.Terrain_e]int{
+	terrain.Alps:                 15,
+	terrain.AridHills:            5,
+	terrain.AridTundra:           4,
+	terrain.BrushFlat:            4,
+	terrain.BrushHills:           5,
+	terrain.ConiferHills:         6,
+	terrain.Deciduous:            5,
+	terrain.DeciduousHills:       6,
+	terrain.Desert:               5,
+	terrain.GrassyHills:          5,
+	terrain.GrassyHillsPlateau:   5,
+	terrain.HighSnowyMountains:   15,
+	terrain.Jungle:               6,
+	terrain.JungleHills:          7,
+	terrain.LowAridMountains:     10,
+	terrain.LowConiferMountains:  10,
+	terrain.LowJungleMountains:   12,
+	terrain.LowSnowyMountains:    12,
+	terrain.LowVolcanicMountains: 12,
+	terrain.PolarIce:             10,
+	terrain.Prairie:              3,
+	terrain.PrairiePlateau:       5,
+	terrain.RockyHills:           8,
+	terrain.SnowyHills:           7,
+	terrain.Swamp:                8,
+	terrain.Tundra:               4,
+}
+
+// PassCost is the cost of entering a tile through a pass.
+// It replaces the terrain cost when the pass makes it cheaper.
+const PassCost = 5
+
+// Step_t is a single move on the route.
+type Step_t struct {
+	From      coords.Map
+	To        coords.Map
+	Direction direction.Direction_e
+	Terrain   terrain.Terrain_e // terrain of the tile entered
+	Edges     []edges.Edge_e    // features on the border crossed
+	Cost      int               // cost of this step
+	Total     int               // cost of the route through this step
+}
+
+// Route_t is the cheapest known path between two tiles.
+type Route_t struct {
+	From  coords.Map
+	To    coords.Map
+	Steps []*Step_t
+	Cost  int
+}
+
+// Order returns the route as a move order, for example "Move NE\SE\S".
+func (r *Route_t) Order() string {
+	if len(r.Steps) == 0 {
+		return "Move"
+	}
+	var ds []string
+	for _, step := range r.Steps {
+		ds = append(ds, step.Direction.String())
+	}
+	return "Move " + strings.Join(ds, `\`)
+}
+
+// Find returns the cheapest known route between the two tiles.
+//
+// A tile can only be entered if its terrain has a cost. A river can only be
+// crossed at a ford and a pass makes mountains cheaper to enter. Borders that
+// have blocked movement from either side, or prohibited movement from the
+// tile being left, are never crossed.
+func Find(worldMap *tiles.Map_t, from, to coords.Map) (*Route_t, error) {
+	if worldMap.Tiles[from] == nil {
+		return nil, fmt.Errorf("%s: %w", from.GridString(), ErrUnknownTile)
+	} else if worldMap.Tiles[to] == nil {
+		return nil, fmt.Errorf("%s: %w", to.GridString(), ErrUnknownTile)
+	}
+
+	// classic dijkstra. prev records the step used to reach each tile.
+	dist := map[coords.Map]int{from: 0}
+	prev := map[coords.Map]*Step_t{}
+	pq := &queue_t{}
+	heap.Push(pq, &node_t{location: from})
+	for pq.Len() != 0 {
+		n := heap.Pop(pq).(*node_t)
+		if n.cost > dist[n.location] {
+			continue // stale entry
+		} else if n.location == to {
+			break
+		}
+		for _, d := range direction.Directions {
+			step, ok := stepCost(worldMap, n.location, d)
+			if !ok {
+				continue
+			}
+			total := n.cost + step.Cost
+			if cost, ok := dist[step.To]; ok && cost <= total {
+				continue
+			}
+			step.Total = total
+			dist[step.To], prev[step.To] = total, step
+			heap.Push(pq, &node_t{location: step.To, cost: total})
+		}
+	}
+
+	cost, ok := dist[to]
+	if !ok {
+		return nil, fmt.Errorf("%s to %s: %w", from.GridString(), to.GridString(), ErrNoRoute)
+	}
+	r := &Route_t{From: from, To: to, Cost: cost}
+	for at := to; at != from; at = prev[at].From {
+		r.Steps = append(r.Steps, prev[at])
+	}
+	for i, j := 0, len(r.Steps)-1; i < j; i, j = i+1, j-1 {
+		r.Steps[i], r.Steps[j] = r.Steps[j], r.Steps[i]
+	}
+	return r, nil
+}
+
+// stepCost returns the step for moving from the tile in the given direction.
+// It returns false if the move isn't allowed.
+func stepCost(worldMap *tiles.Map_t, from coords.Map, d direction.Direction_e) (*Step_t, bool) {
+	src := worldMap.Tiles[from]
+	dst := worldMap.Tiles[from.Add(d)]
+	if src == nil || dst == nil {
+		return nil, false
+	}
+	cost, ok := Costs[dst.Terrain]
+	if !ok {
+		return nil, false
+	}
+
+	// the border may have been reported from either side
+	border := append([]edges.Edge_e{}, src.Edges[d]...)
+	for _, e := range dst.Edges[direction.Opposite[d]] {
+		if !hasEdge(border, e) {
+			border = append(border, e)
+		}
+	}
+	if hasEdge(border, edges.River) && !hasEdge(border, edges.Ford) {
+		return nil, false
+	} else if hasEdge(border, edges.Pass) && PassCost < cost {
+		cost = PassCost
+	}
+
+	for _, obstacle := range src.Obstacles {
+		if obstacle.Direction == d && (obstacle.Result == results.Blocked || obstacle.Result == results.Prohibited) {
+			return nil, false
+		}
+	}
+	// an edge that stopped a unit on the far side blocks both ways.
+	// a prohibited move from the far side doesn't, since it was this tile's terrain that stopped it.
+	for _, obstacle := range dst.Obstacles {
+		if obstacle.Direction == direction.Opposite[d] && obstacle.Result == results.Blocked && obstacle.Edge != edges.None {
+			return nil, false
+		}
+	}
+
+	return &Step_t{
+		From:      from,
+		To:        from.Add(d),
+		Direction: d,
+		Terrain:   dst.Terrain,
+		Edges:     border,
+		Cost:      cost,
+	}, true
+}
+
+func hasEdge(list []edges.Edge_e, e edges.Edge_e) bool {
+	for _, l := range list {
+		if l == e {
+			return true
+		}
+	}
+	return false
+}
+
+// node_t is an entry in the priority queue.
+type node_t struct {
+	location coords.Map
+	cost     int
+}
+
+// queue_t implements heap.Interface for the nodes.
+type queue_t []*node_t
+
+func (q queue_t) Len() int { return len(q) }
+
+func (q queue_t) Less(i, j int) bool { return q[i].cost < q[j].cost }
+
+func (q queue_t) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *queue_t) Push(x any) { *q = append(*q, x.(*node_t)) }
+
+func (q *queue_t) Pop() any {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return n
+}
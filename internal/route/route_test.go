@@ -0,0 +1,66 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package route_test
+
+import (
+	"errors"
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/direction"
+	"github.com/mdhender/ottomap/internal/edges"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/results"
+	"github.com/mdhender/ottomap/internal/route"
+	"github.com/mdhender/ottomap/internal/terrain"
+	"github.com/mdhender/ottomap/internal/tiles"
+	"testing"
+)
+
+func TestFind(t *testing.T) {
+	from, err := coords.HexToMap("MH 0714")
+	if err != nil {
+		t.Fatalf("hex: %v", err)
+	}
+
+	// a prairie tile to the north and a swamp to the north-east, with
+	// a river between the start and the prairie.
+	worldMap := tiles.NewMap()
+	worldMap.FetchTile(from).Terrain = terrain.Prairie
+	worldMap.FetchTile(from.Add(direction.North)).Terrain = terrain.Prairie
+	worldMap.FetchTile(from.Add(direction.NorthEast)).Terrain = terrain.Swamp
+	worldMap.FetchTile(from).MergeEdge(direction.North, edges.River)
+	to := from.Add(direction.North)
+
+	r, err := route.Find(worldMap, from, to)
+	if err != nil {
+		t.Fatalf("river: want route around, got %v", err)
+	} else if got := r.Order(); got != `Move NE\NW` {
+		t.Errorf("river: want %q, got %q", `Move NE\NW`, got)
+	} else if want := route.Costs[terrain.Swamp] + route.Costs[terrain.Prairie]; r.Cost != want {
+		t.Errorf("river: cost: want %d, got %d", want, r.Cost)
+	}
+
+	// the ford lets us cross directly. it is reported on the far side
+	// to make sure that both sides of the border are checked.
+	worldMap.FetchTile(to).MergeEdge(direction.South, edges.Ford)
+	r, err = route.Find(worldMap, from, to)
+	if err != nil {
+		t.Fatalf("ford: %v", err)
+	} else if got := r.Order(); got != `Move N` {
+		t.Errorf("ford: want %q, got %q", `Move N`, got)
+	}
+
+	// a unit on the far side was stopped by the river, so the ford can't be used
+	worldMap.FetchTile(to).Obstacles = append(worldMap.FetchTile(to).Obstacles, &parser.Obstacle_t{Direction: direction.South, Result: results.Blocked, Edge: edges.River})
+	r, err = route.Find(worldMap, from, to)
+	if err != nil {
+		t.Fatalf("obstacle: want route around, got %v", err)
+	} else if got := r.Order(); got != `Move NE\NW` {
+		t.Errorf("obstacle: want %q, got %q", `Move NE\NW`, got)
+	}
+
+	// water can't be entered
+	worldMap.FetchTile(from.Add(direction.South)).Terrain = terrain.Ocean
+	if _, err = route.Find(worldMap, from, from.Add(direction.South)); !errors.Is(err, route.ErrNoRoute) {
+		t.Errorf("ocean: want %v, got %v", route.ErrNoRoute, err)
+	}
+}
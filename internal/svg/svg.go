@@ -0,0 +1,202 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+// Package svg draws the map as a standalone SVG file that can be viewed in a browser.
+//
+// The hexes use the same geometry as the Worldographer map, so a hex is
+// 300 units wide and the features are in the same places on both maps.
+package svg
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/mdhender/ottomap/internal/direction"
+	"github.com/mdhender/ottomap/internal/terrain"
+	"github.com/mdhender/ottomap/internal/wxx"
+	"html"
+	"log"
+	"os"
+	"strings"
+)
+
+// Create writes the hexes and trails to an SVG file.
+// Only the encounters from turnId are drawn.
+func Create(path, turnId string, hexes []*wxx.Hex, trails []*wxx.Trail, cfg wxx.RenderConfig) error {
+	if len(hexes) == 0 {
+		return fmt.Errorf("svg: create: no hexes")
+	}
+	log.Printf("svg: create: %d hexes\n", len(hexes))
+
+	// find the bounds of the drawing and the offset from the true location to the render location
+	topLeft, bottomRight, renderOffset := wxx.Bounds(hexes)
+	minX, minY, maxX, maxY := topLeft.X, topLeft.Y, bottomRight.X, bottomRight.Y
+
+	b := &bytes.Buffer{}
+	// the hexes are drawn 50 pixels wide; the browser can zoom from there
+	_, _ = fmt.Fprintf(b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="%g %g %g %g">`+"\n", int((maxX-minX)/6), int((maxY-minY)/6), minX, minY, maxX-minX, maxY-minY)
+	_, _ = fmt.Fprintf(b, `<rect x="%g" y="%g" width="%g" height="%g" fill="#ffffff"/>`+"\n", minX, minY, maxX-minX, maxY-minY)
+
+	// layers are drawn from the bottom up, so terrain first and labels last
+	_, _ = fmt.Fprintf(b, `<g id="terrain" stroke="#808080" stroke-width="3">`+"\n")
+	for _, hex := range hexes {
+		points := wxx.HexPoints(hex.RenderAt.Column, hex.RenderAt.Row)
+		_, _ = fmt.Fprintf(b, `<polygon points="%s" fill="%s"><title>%s %s</title></polygon>`+"\n", polygon(points), terrainColor(hex.Terrain), hex.Location.GridString(), html.EscapeString(hex.Terrain.String()))
+	}
+	_, _ = fmt.Fprintf(b, "</g>\n")
+
+	_, _ = fmt.Fprintf(b, `<g id="edges" stroke-linecap="round">`+"\n")
+	for _, hex := range hexes {
+		writeEdges(b, hex, wxx.HexPoints(hex.RenderAt.Column, hex.RenderAt.Row))
+	}
+	_, _ = fmt.Fprintf(b, "</g>\n")
+
+	if len(trails) != 0 {
+		_, _ = fmt.Fprintf(b, `<g id="trails" fill="none" stroke-width="9" stroke-linejoin="round" stroke-linecap="round" opacity="0.75">`+"\n")
+		for _, trail := range trails {
+			points := wxx.TrailPoints(trail, renderOffset)
+			if len(points) < 2 {
+				continue
+			}
+			var list []string
+			for _, p := range points {
+				list = append(list, fmt.Sprintf("%g,%g", p.X, p.Y))
+			}
+			r, g, bl := trail.RGB()
+			_, _ = fmt.Fprintf(b, `<polyline points="%s" stroke="%s"><title>%s</title></polyline>`+"\n", strings.Join(list, " "), rgb(r, g, bl), trail.UnitId)
+		}
+		_, _ = fmt.Fprintf(b, "</g>\n")
+	}
+
+	_, _ = fmt.Fprintf(b, `<g id="features" font-family="sans-serif" text-anchor="middle">`+"\n")
+	for _, hex := range hexes {
+		writeFeatures(b, hex, wxx.HexPoints(hex.RenderAt.Column, hex.RenderAt.Row), turnId, cfg)
+	}
+	_, _ = fmt.Fprintf(b, "</g>\n")
+
+	_, _ = fmt.Fprintf(b, "</svg>\n")
+
+	if err := os.WriteFile(path, b.Bytes(), 0644); err != nil {
+		return err
+	}
+	log.Printf("svg: created %s\n", path)
+	return nil
+}
+
+// writeEdges draws the rivers, fords, passes, and stone roads on the edges of the hex.
+func writeEdges(b *bytes.Buffer, hex *wxx.Hex, points [7]wxx.Point) {
+	fords := map[direction.Direction_e]bool{}
+	for _, dir := range hex.Features.Edges.Ford {
+		// a ford is a river with a gap in the middle
+		fords[dir] = true
+		from, to := wxx.EdgeVertices(dir, points)
+		ford := wxx.EdgeCenter(dir, points)
+		line(b, from, wxx.Midpoint(from, ford), "#99ccff", 20)
+		line(b, wxx.Midpoint(to, ford), to, "#99ccff", 20)
+	}
+	for _, dir := range hex.Features.Edges.River {
+		if fords[dir] {
+			continue
+		}
+		from, to := wxx.EdgeVertices(dir, points)
+		line(b, from, to, "#99ccff", 20)
+	}
+	for _, dir := range hex.Features.Edges.Pass {
+		end := wxx.EdgeCenter(dir, points)
+		start := wxx.Midpoint(wxx.Midpoint(points[0], end), end)
+		line(b, start, end, "#ffff00", 27)
+	}
+	for _, dir := range hex.Features.Edges.StoneRoad {
+		end := wxx.EdgeCenter(dir, points)
+		start := wxx.Midpoint(wxx.Midpoint(points[0], end), end)
+		line(b, start, end, "#b3b3b3", 15)
+	}
+}
+
+// writeFeatures draws the coordinates, resources, settlements, and encounters in the hex.
+func writeFeatures(b *bytes.Buffer, hex *wxx.Hex, points [7]wxx.Point, turnId string, cfg wxx.RenderConfig) {
+	center := points[0]
+
+	if hex.Terrain != terrain.Blank {
+		if cfg.Show.Grid.Coords {
+			text(b, wxx.Point{X: center.X, Y: center.Y - 105}, 30, "#000000", hex.Location.GridString())
+		} else if cfg.Show.Grid.Numbers {
+			text(b, wxx.Point{X: center.X, Y: center.Y - 105}, 30, "#000000", hex.Location.GridString()[3:])
+		}
+	}
+
+	for n, resource := range hex.Features.Resources {
+		at := wxx.Point{X: center.X, Y: center.Y - 60 + float64(n)*30}
+		_, _ = fmt.Fprintf(b, `<rect x="%g" y="%g" width="24" height="24" fill="#8b4513"/>`+"\n", at.X-90, at.Y-20)
+		text(b, at, 26, "#000000", resource.String())
+	}
+
+	for _, s := range hex.Features.Settlements {
+		if s == nil || s.Name == "" || strings.HasPrefix(s.Name, "_") {
+			continue
+		}
+		_, _ = fmt.Fprintf(b, `<rect x="%g" y="%g" width="40" height="40" fill="#000000" stroke="#ffffff" stroke-width="4"/>`+"\n", center.X-20, center.Y-20)
+		at := wxx.EdgeCenter(direction.South, points)
+		text(b, wxx.Point{X: at.X, Y: at.Y - 20}, 36, "#000000", strings.Trim(s.Name, "_"))
+	}
+
+	// friendly units are drawn to the north-east and other units to the north-west
+	var friends, others []string
+	for _, encounter := range hex.Features.Encounters {
+		if encounter.TurnId != turnId {
+			// only show encounters that are in the current turn
+			continue
+		} else if encounter.Friendly {
+			friends = append(friends, string(encounter.UnitId))
+		} else {
+			others = append(others, string(encounter.UnitId))
+		}
+	}
+	for _, group := range []struct {
+		units []string
+		edge  direction.Direction_e
+		color string
+	}{
+		{friends, direction.NorthEast, "#000000"},
+		{others, direction.NorthWest, "#ff0000"},
+	} {
+		if len(group.units) == 0 {
+			continue
+		}
+		at := wxx.Midpoint(center, wxx.EdgeCenter(group.edge, points))
+		_, _ = fmt.Fprintf(b, `<circle cx="%g" cy="%g" r="16" fill="%s"><title>%s</title></circle>`+"\n", at.X, at.Y, group.color, strings.Join(group.units, " "))
+		label := group.units[0]
+		if len(group.units) > 1 {
+			label = fmt.Sprintf("%s +%d", label, len(group.units)-1)
+		}
+		text(b, wxx.Point{X: at.X, Y: at.Y + 45}, 24, group.color, label)
+	}
+}
+
+func line(b *bytes.Buffer, from, to wxx.Point, color string, width float64) {
+	_, _ = fmt.Fprintf(b, `<line x1="%g" y1="%g" x2="%g" y2="%g" stroke="%s" stroke-width="%g"/>`+"\n", from.X, from.Y, to.X, to.Y, color, width)
+}
+
+func text(b *bytes.Buffer, at wxx.Point, size float64, color, s string) {
+	_, _ = fmt.Fprintf(b, `<text x="%g" y="%g" font-size="%g" fill="%s">%s</text>`+"\n", at.X, at.Y, size, color, html.EscapeString(s))
+}
+
+// polygon returns the vertices of the hex as a list of points for the polygon element.
+func polygon(points [7]wxx.Point) string {
+	var list []string
+	for _, p := range points[1:] {
+		list = append(list, fmt.Sprintf("%g,%g", p.X, p.Y))
+	}
+	return strings.Join(list, " ")
+}
+
+// rgb converts a color with components from 0 to 1 to an SVG color.
+func rgb(r, g, b float64) string {
+	return fmt.Sprintf("#%02x%02x%02x", int(r*255+0.5), int(g*255+0.5), int(b*255+0.5))
+}
+
+// terrainColor returns the fill color for the terrain.
+func terrainColor(t terrain.Terrain_e) string {
+	if color, ok := terrain.TileColors[t]; ok {
+		return color
+	}
+	return terrain.TileColors[terrain.Blank]
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package svg_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/direction"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/svg"
+	"github.com/mdhender/ottomap/internal/terrain"
+	"github.com/mdhender/ottomap/internal/wxx"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCreate(t *testing.T) {
+	hex := &wxx.Hex{
+		Location: coords.Map{Column: 10, Row: 10},
+		RenderAt: coords.Map{Column: 4, Row: 4},
+		Terrain:  terrain.Prairie,
+	}
+	hex.Features.Edges.River = []direction.Direction_e{direction.North}
+	hex.Features.Settlements = []*parser.Settlement_t{{Name: "Fish & Chips"}}
+	hex.Features.Encounters = []*parser.Encounter_t{{TurnId: "0900-01", UnitId: "0991e1", Friendly: true}}
+
+	path := filepath.Join(t.TempDir(), "0991.svg")
+	if err := svg.Create(path, "0900-01", []*wxx.Hex{hex}, nil, wxx.RenderConfig{}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+
+	// the file must be valid XML so that browsers will show it
+	elements := map[string]int{}
+	for d := xml.NewDecoder(bytes.NewReader(data)); ; {
+		token, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			t.Fatalf("xml: %v", err)
+		}
+		if se, ok := token.(xml.StartElement); ok {
+			elements[se.Name.Local]++
+		}
+	}
+	for _, tc := range []struct {
+		element string
+		want    int
+	}{
+		{"svg", 1},
+		{"polygon", 1},
+		{"line", 1},
+		{"circle", 1},
+	} {
+		if got := elements[tc.element]; got != tc.want {
+			t.Errorf("%s: want %d, got %d", tc.element, tc.want, got)
+		}
+	}
+
+	if err := svg.Create(path, "0900-01", nil, nil, wxx.RenderConfig{}); err == nil {
+		t.Errorf("no hexes: want error, got nil")
+	}
+}
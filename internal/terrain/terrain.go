@@ -186,4 +186,40 @@ var (
 		UnknownLand:          "Flat Moss",
 		UnknownWater:         "Water Reefs",
 	}
+	// TileColors is the fill color for the terrain on maps that don't use
+	// the Worldographer tileset, like the SVG and PNG maps. The colors are
+	// close to the colors of the Worldographer tiles.
+	TileColors = map[Terrain_e]string{
+		Blank:                "#ffffff",
+		Alps:                 "#8c8c8c",
+		AridHills:            "#c8a86b",
+		AridTundra:           "#b5b58c",
+		BrushFlat:            "#a3b86c",
+		BrushHills:           "#8fa65a",
+		ConiferHills:         "#3f6e3f",
+		Deciduous:            "#4c8c3c",
+		DeciduousHills:       "#5e8c46",
+		Desert:               "#e8d48c",
+		GrassyHills:          "#9cc46c",
+		GrassyHillsPlateau:   "#a8cc78",
+		HighSnowyMountains:   "#f0f0f0",
+		Jungle:               "#2e7d32",
+		JungleHills:          "#387a3a",
+		Lake:                 "#7fb2e5",
+		LowAridMountains:     "#a08060",
+		LowConiferMountains:  "#5c7a5c",
+		LowJungleMountains:   "#4a7a4a",
+		LowSnowyMountains:    "#d8d8e0",
+		LowVolcanicMountains: "#6e4c4c",
+		Ocean:                "#3c78c8",
+		PolarIce:             "#e0f0ff",
+		Prairie:              "#c4d88c",
+		PrairiePlateau:       "#b8d080",
+		RockyHills:           "#9a8c7a",
+		SnowyHills:           "#e8e8f0",
+		Swamp:                "#6b8e6b",
+		Tundra:               "#a8b48c",
+		UnknownLand:          "#d8c8a8",
+		UnknownWater:         "#a8c8e8",
+	}
 )
@@ -0,0 +1,133 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"fmt"
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/direction"
+	"github.com/mdhender/ottomap/internal/edges"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/resources"
+	"slices"
+	"sort"
+)
+
+// Change_e is an enum for the kinds of changes between two maps.
+type Change_e int
+
+const (
+	NewHex Change_e = iota
+	NewTerrain
+	NewSettlement
+	NewResource
+	NewEncounter
+	NewEdge
+)
+
+// String implements the fmt.Stringer interface.
+func (e Change_e) String() string {
+	if str, ok := ChangeEnumToString[e]; ok {
+		return str
+	}
+	return fmt.Sprintf("Change(%d)", int(e))
+}
+
+var (
+	// ChangeEnumToString is a helper map for printing the enum
+	ChangeEnumToString = map[Change_e]string{
+		NewHex:        "hex",
+		NewTerrain:    "terrain",
+		NewSettlement: "settlement",
+		NewResource:   "resource",
+		NewEncounter:  "encounter",
+		NewEdge:       "edge",
+	}
+)
+
+// Change_t is something in a later map that isn't in an earlier map.
+type Change_t struct {
+	Location coords.Map
+	Kind     Change_e
+	Text     string // what changed, for example "PR -> GH" or "NE River"
+}
+
+// Diff returns the changes from the earlier map to the later map, sorted by
+// location, along with a map of the tiles that changed. The tiles in the
+// changed map have the later terrain but only the new settlements,
+// resources, encounters, and edges.
+func Diff(from, to *Map_t) ([]*Change_t, *Map_t) {
+	var changes []*Change_t
+	changed := NewMap()
+
+	var locations []coords.Map
+	for location := range to.Tiles {
+		locations = append(locations, location)
+	}
+	sort.Slice(locations, func(i, j int) bool {
+		return locations[i].GridString() < locations[j].GridString()
+	})
+
+	for _, location := range locations {
+		t := to.Tiles[location]
+		// old is an empty tile if the hex wasn't on the earlier map
+		old, ok := from.Tiles[location]
+		if !ok {
+			old = &Tile_t{Location: location}
+		}
+		n := len(changes)
+		add := func(kind Change_e, text string) {
+			changes = append(changes, &Change_t{Location: location, Kind: kind, Text: text})
+		}
+		diff := &Tile_t{
+			Location: t.Location,
+			Visited:  t.Visited,
+			Scouted:  t.Scouted,
+			Terrain:  t.Terrain,
+		}
+
+		if !ok {
+			add(NewHex, t.Terrain.String())
+		} else if old.Terrain != t.Terrain {
+			add(NewTerrain, fmt.Sprintf("%s -> %s", old.Terrain, t.Terrain))
+		}
+
+		for _, s := range t.Settlements {
+			if s == nil {
+				continue
+			} else if !slices.ContainsFunc(old.Settlements, func(o *parser.Settlement_t) bool { return o != nil && o.Name == s.Name }) {
+				add(NewSettlement, s.Name)
+				diff.Settlements = append(diff.Settlements, s)
+			}
+		}
+
+		for _, r := range t.Resources {
+			if r != resources.None && !slices.Contains(old.Resources, r) {
+				add(NewResource, r.String())
+				diff.Resources = append(diff.Resources, r)
+			}
+		}
+
+		for _, e := range t.Encounters {
+			if !slices.ContainsFunc(old.Encounters, func(o *parser.Encounter_t) bool { return o.TurnId == e.TurnId && o.UnitId == e.UnitId }) {
+				add(NewEncounter, fmt.Sprintf("%s %s", e.TurnId, e.UnitId))
+				diff.Encounters = append(diff.Encounters, e)
+			}
+		}
+
+		for _, d := range direction.Directions {
+			for _, edge := range t.Edges[d] {
+				if edge != edges.None && !slices.Contains(old.Edges[d], edge) {
+					add(NewEdge, fmt.Sprintf("%s %s", d, edge))
+					diff.Edges[d] = append(diff.Edges[d], edge)
+				}
+			}
+		}
+
+		if len(changes) != n {
+			changed.Tiles[location] = diff
+		}
+	}
+
+	return changes, changed
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package tiles_test
+
+import (
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/direction"
+	"github.com/mdhender/ottomap/internal/edges"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/resources"
+	"github.com/mdhender/ottomap/internal/terrain"
+	"github.com/mdhender/ottomap/internal/tiles"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	unchanged, updated, settled, added := coords.Map{Column: 1, Row: 1}, coords.Map{Column: 2, Row: 1}, coords.Map{Column: 3, Row: 1}, coords.Map{Column: 4, Row: 1}
+	mined, met, bordered := coords.Map{Column: 5, Row: 1}, coords.Map{Column: 6, Row: 1}, coords.Map{Column: 7, Row: 1}
+	village := &parser.Settlement_t{TurnId: "0901-02", Name: "Village"}
+	oldEncounter, newEncounter := &parser.Encounter_t{TurnId: "0901-01", UnitId: "0992"}, &parser.Encounter_t{TurnId: "0901-02", UnitId: "0993"}
+
+	from, to := tiles.NewMap(), tiles.NewMap()
+	from.Tiles[unchanged] = &tiles.Tile_t{Location: unchanged, Visited: "0901-01", Terrain: terrain.Prairie}
+	from.Tiles[updated] = &tiles.Tile_t{Location: updated, Terrain: terrain.UnknownLand}
+	from.Tiles[settled] = &tiles.Tile_t{Location: settled, Visited: "0901-01", Terrain: terrain.GrassyHills}
+	from.Tiles[mined] = &tiles.Tile_t{Location: mined, Visited: "0901-01", Terrain: terrain.RockyHills, Resources: []resources.Resource_e{resources.Coal}}
+	from.Tiles[met] = &tiles.Tile_t{Location: met, Visited: "0901-01", Terrain: terrain.Prairie, Encounters: []*parser.Encounter_t{oldEncounter}}
+	from.Tiles[bordered] = &tiles.Tile_t{Location: bordered, Visited: "0901-01", Terrain: terrain.Prairie}
+	from.Tiles[bordered].Edges[direction.North] = []edges.Edge_e{edges.River}
+	// the unchanged hex was visited again, which is not a change
+	to.Tiles[unchanged] = &tiles.Tile_t{Location: unchanged, Visited: "0901-02", Terrain: terrain.Prairie}
+	to.Tiles[updated] = &tiles.Tile_t{Location: updated, Scouted: "0901-02", Terrain: terrain.Prairie}
+	to.Tiles[settled] = &tiles.Tile_t{Location: settled, Visited: "0901-02", Terrain: terrain.GrassyHills, Settlements: []*parser.Settlement_t{village}}
+	to.Tiles[added] = &tiles.Tile_t{Location: added, Visited: "0901-02", Terrain: terrain.Swamp}
+	// only the resources, encounters, and edges that are new are changes
+	to.Tiles[mined] = &tiles.Tile_t{Location: mined, Visited: "0901-02", Terrain: terrain.RockyHills, Resources: []resources.Resource_e{resources.Coal, resources.IronOre}}
+	to.Tiles[met] = &tiles.Tile_t{Location: met, Visited: "0901-02", Terrain: terrain.Prairie, Encounters: []*parser.Encounter_t{oldEncounter, newEncounter}}
+	to.Tiles[bordered] = &tiles.Tile_t{Location: bordered, Visited: "0901-02", Terrain: terrain.Prairie}
+	to.Tiles[bordered].Edges[direction.North] = []edges.Edge_e{edges.River, edges.Ford}
+	to.Tiles[bordered].Edges[direction.SouthEast] = []edges.Edge_e{edges.Pass}
+
+	changes, changed := tiles.Diff(from, to)
+
+	want := []struct {
+		location coords.Map
+		kind     tiles.Change_e
+		text     string
+	}{
+		{updated, tiles.NewTerrain, "UL -> PR"},
+		{settled, tiles.NewSettlement, "Village"},
+		{added, tiles.NewHex, "SW"},
+		{mined, tiles.NewResource, "Iron Ore"},
+		{met, tiles.NewEncounter, "0901-02 0993"},
+		{bordered, tiles.NewEdge, "N Ford"},
+		{bordered, tiles.NewEdge, "SE Pass"},
+	}
+	if len(changes) != len(want) {
+		for _, c := range changes {
+			t.Logf("change: %s %s %q", c.Location.GridString(), c.Kind, c.Text)
+		}
+		t.Fatalf("changes: want %d, got %d", len(want), len(changes))
+	}
+	for n, w := range want {
+		if c := changes[n]; c.Location != w.location || c.Kind != w.kind || c.Text != w.text {
+			t.Errorf("change %d: want %s %s %q, got %s %s %q", n+1, w.location.GridString(), w.kind, w.text, c.Location.GridString(), c.Kind, c.Text)
+		}
+	}
+
+	if _, ok := changed.Tiles[unchanged]; ok {
+		t.Errorf("unchanged: want no tile, got tile")
+	}
+	if tile := changed.Tiles[updated]; tile == nil || tile.Terrain != terrain.Prairie {
+		t.Errorf("updated: want PR tile, got %v", tile)
+	}
+	if tile := changed.Tiles[settled]; tile == nil || len(tile.Settlements) != 1 || tile.Settlements[0] != village {
+		t.Errorf("settled: want tile with the new settlement, got %v", tile)
+	}
+	if tile := changed.Tiles[added]; tile == nil || tile.Terrain != terrain.Swamp {
+		t.Errorf("added: want SW tile, got %v", tile)
+	}
+	if tile := changed.Tiles[mined]; tile == nil || len(tile.Resources) != 1 || tile.Resources[0] != resources.IronOre {
+		t.Errorf("mined: want tile with the new resource, got %v", tile)
+	}
+	if tile := changed.Tiles[met]; tile == nil || len(tile.Encounters) != 1 || tile.Encounters[0] != newEncounter {
+		t.Errorf("met: want tile with the new encounter, got %v", tile)
+	}
+	if tile := changed.Tiles[bordered]; tile == nil {
+		t.Errorf("bordered: want tile with the new edges, got nil")
+	} else if n, se := tile.Edges[direction.North], tile.Edges[direction.SouthEast]; len(n) != 1 || n[0] != edges.Ford || len(se) != 1 || se[0] != edges.Pass {
+		t.Errorf("bordered: want N Ford and SE Pass, got %v and %v", n, se)
+	}
+
+	// a map has no changes from itself
+	if changes, changed := tiles.Diff(to, to); len(changes) != 0 || changed.Length() != 0 {
+		t.Errorf("same map: want no changes, got %d changes, %d tiles", len(changes), changed.Length())
+	}
+}
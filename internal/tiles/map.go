@@ -3,7 +3,9 @@
 package tiles
 
 import (
+	"fmt"
 	"github.com/mdhender/ottomap/internal/coords"
+	"io"
 	"sort"
 )
 
@@ -61,6 +63,34 @@ func (m *Map_t) Dump() {
 	}
 }
 
+// DumpItems writes a line for every item found on the map, sorted by location and turn.
+// It returns the number of items written.
+func (m *Map_t) DumpItems(w io.Writer) (int, error) {
+	var sortedTiles []*Tile_t
+	for _, tile := range m.Tiles {
+		if len(tile.Items) != 0 {
+			sortedTiles = append(sortedTiles, tile)
+		}
+	}
+	sort.Slice(sortedTiles, func(i, j int) bool {
+		return sortedTiles[i].Location.GridString() < sortedTiles[j].Location.GridString()
+	})
+	n := 0
+	for _, tile := range sortedTiles {
+		items := tile.Items
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].TurnId < items[j].TurnId
+		})
+		for _, item := range items {
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%8d\t%s\n", tile.Location.GridString(), item.TurnId, item.Quantity, item.Item); err != nil {
+				return n, err
+			}
+			n++
+		}
+	}
+	return n, nil
+}
+
 func (m *Map_t) Length() int {
 	if m == nil {
 		return 0
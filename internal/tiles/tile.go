@@ -3,7 +3,9 @@
 package tiles
 
 import (
+	"errors"
 	"fmt"
+	"github.com/mdhender/ottomap/cerrs"
 	"github.com/mdhender/ottomap/internal/compass"
 	"github.com/mdhender/ottomap/internal/coords"
 	"github.com/mdhender/ottomap/internal/direction"
@@ -29,8 +31,14 @@ type Tile_t struct {
 
 	// transient items in this tile
 	Encounters  []*parser.Encounter_t // other units in this tile
+	Items       []*parser.FoundItem_t // items found in this tile, one entry per turn and item
+	Longhouses  []*parser.Longhouse_t // longhouses in this tile
+	Obstacles   []*parser.Obstacle_t  // borders that stopped units from leaving this tile
+	Patrols     []*parser.Patrol_t    // outcomes of scouting parties in this tile
 	Resources   []resources.Resource_e
 	Settlements []*parser.Settlement_t
+	Sightings   []*parser.Sighting_t // crow's-nest sightings of this tile
+	Winds       []*parser.Wind_t     // winds reported by fleets in this tile
 }
 
 func (t *Tile_t) Dump() {
@@ -49,11 +57,14 @@ func (t *Tile_t) Dump() {
 }
 
 // MergeReports merges the reports from two tiles.
+// It returns an error if the input is not sorted by turn.
+// Otherwise, it returns any terrain conflicts found while merging; those are
+// not fatal since the newest terrain replaces the old.
 func (t *Tile_t) MergeReports(turnId string, report *parser.Report_t, worldMap *Map_t, scouting bool) error {
 	// update flags for visited and scouted.
-	// panic if the input is not sorted by turn.
+	// return an error if the input is not sorted by turn.
 	if !(t.Visited <= turnId) {
-		panic(fmt.Sprintf("assert(%q <= %q)", t.Visited, turnId))
+		return fmt.Errorf("%s: %w: visited %q, merging %q", t.Location.GridString(), cerrs.ErrTurnsOutOfOrder, t.Visited, turnId)
 	}
 	t.Visited = turnId
 	if scouting {
@@ -61,38 +72,55 @@ func (t *Tile_t) MergeReports(turnId string, report *parser.Report_t, worldMap *
 	}
 
 	// merge the reports from this move into the tile
-	t.MergeTerrain(report.Terrain)
+	var conflicts []error
+	if err := t.MergeTerrain(report.Terrain); err != nil {
+		conflicts = append(conflicts, err)
+	}
 	for _, border := range report.Borders {
-		t.MergeBorder(border, worldMap)
+		if err := t.MergeBorder(border, worldMap); err != nil {
+			conflicts = append(conflicts, err)
+		}
 		t.MergeEdge(border.Direction, border.Edge)
 	}
 	for _, encounter := range report.Encounters {
 		t.MergeEncounter(encounter)
 	}
 	for _, fh := range report.FarHorizons {
-		t.MergeFarHorizon(fh, worldMap)
+		if err := t.MergeFarHorizon(fh, worldMap); err != nil {
+			conflicts = append(conflicts, err)
+		}
 	}
 	for _, item := range report.Items {
 		t.MergeItem(item)
 	}
+	for _, lh := range report.Longhouses {
+		t.MergeLonghouse(lh)
+	}
+	for _, obstacle := range report.Obstacles {
+		t.MergeObstacle(obstacle)
+	}
+	for _, patrol := range report.Patrols {
+		t.MergePatrol(patrol)
+	}
 	for _, resource := range report.Resources {
 		t.MergeResource(resource)
 	}
 	for _, settlement := range report.Settlements {
 		t.MergeSettlement(settlement)
 	}
+	t.MergeWind(report.Wind)
 
-	return nil
+	return errors.Join(conflicts...)
 }
 
 // MergeBorder merges a new border into the tile.
-func (t *Tile_t) MergeBorder(border *parser.Border_t, worldMap *Map_t) {
+func (t *Tile_t) MergeBorder(border *parser.Border_t, worldMap *Map_t) error {
 	if border.Terrain == terrain.Blank {
-		return
+		return nil
 	}
 	// create neighbor with terrain
 	neighbor := worldMap.FetchTile(t.Location.Add(border.Direction))
-	neighbor.MergeTerrain(border.Terrain)
+	return neighbor.MergeTerrain(border.Terrain)
 }
 
 // MergeEdge merges a new edge into the tile.
@@ -119,9 +147,9 @@ func (t *Tile_t) MergeEncounter(e *parser.Encounter_t) {
 }
 
 // MergeFarHorizon merges the far horizon from two tiles.
-func (t *Tile_t) MergeFarHorizon(fh *parser.FarHorizon_t, worldMap *Map_t) {
+func (t *Tile_t) MergeFarHorizon(fh *parser.FarHorizon_t, worldMap *Map_t) error {
 	if fh == nil {
-		return
+		return nil
 	}
 	// find the neighbor that this far horizon report is for
 	var neighbor *Tile_t
@@ -153,12 +181,73 @@ func (t *Tile_t) MergeFarHorizon(fh *parser.FarHorizon_t, worldMap *Map_t) {
 	default:
 		panic(fmt.Sprintf("assert(point != %d)", fh.Point))
 	}
-	neighbor.MergeTerrain(fh.Terrain)
+	neighbor.MergeSighting(&parser.Sighting_t{TurnId: fh.TurnId, UnitId: fh.UnitId, Terrain: fh.Terrain})
+	return neighbor.MergeTerrain(fh.Terrain)
 }
 
 // MergeItem merges a new item into the tile.
+// Items are tracked by turn; if the item was already found this turn,
+// the quantity is replaced since units finding the same goods would
+// otherwise double count them.
 func (t *Tile_t) MergeItem(f *parser.FoundItem_t) {
-	// this is currently a no-op since we don't track items
+	if f == nil {
+		return
+	}
+	for _, l := range t.Items {
+		if l.TurnId == f.TurnId && l.Item == f.Item {
+			l.Quantity = f.Quantity
+			return
+		}
+	}
+	t.Items = append(t.Items, &parser.FoundItem_t{TurnId: f.TurnId, Quantity: f.Quantity, Item: f.Item})
+}
+
+// MergeLonghouse merges a new longhouse into the tile.
+// If the longhouse is already known, the capacity is updated from the newer report.
+func (t *Tile_t) MergeLonghouse(lh *parser.Longhouse_t) {
+	if lh == nil {
+		return
+	}
+	for _, l := range t.Longhouses {
+		if l.Id == lh.Id {
+			if l.TurnId <= lh.TurnId {
+				l.TurnId, l.Capacity = lh.TurnId, lh.Capacity
+			}
+			return
+		}
+	}
+	t.Longhouses = append(t.Longhouses, &parser.Longhouse_t{TurnId: lh.TurnId, Id: lh.Id, Capacity: lh.Capacity})
+}
+
+// MergeObstacle merges a new obstacle into the tile.
+// Obstacles are permanent, so only the latest turn they were reported in is kept.
+func (t *Tile_t) MergeObstacle(o *parser.Obstacle_t) {
+	if o == nil {
+		return
+	}
+	for _, l := range t.Obstacles {
+		if l.Equals(o) {
+			if l.TurnId < o.TurnId {
+				l.TurnId = o.TurnId
+			}
+			return
+		}
+	}
+	obstacle := *o
+	t.Obstacles = append(t.Obstacles, &obstacle)
+}
+
+// MergePatrol merges a new patrol outcome into the tile.
+func (t *Tile_t) MergePatrol(p *parser.Patrol_t) {
+	if p == nil {
+		return
+	}
+	for _, l := range t.Patrols {
+		if l.Equals(p) {
+			return
+		}
+	}
+	t.Patrols = append(t.Patrols, p)
 }
 
 // MergeResource merges a new resource into the tile.
@@ -188,27 +277,64 @@ func (t *Tile_t) MergeSettlement(s *parser.Settlement_t) {
 	t.Settlements = append(t.Settlements, s)
 }
 
-// MergeTerrain if it is not blank and is different
-func (t *Tile_t) MergeTerrain(n terrain.Terrain_e) {
+// MergeSighting merges a crow's-nest sighting into the tile.
+// Only one sighting per turn and fleet is kept.
+func (t *Tile_t) MergeSighting(s *parser.Sighting_t) {
+	if s == nil {
+		return
+	}
+	for _, l := range t.Sightings {
+		if l.TurnId == s.TurnId && l.UnitId == s.UnitId {
+			return
+		}
+	}
+	t.Sightings = append(t.Sightings, s)
+}
+
+// MergeTerrain if it is not blank and is different.
+// It returns an error if the new terrain replaces a different terrain.
+func (t *Tile_t) MergeTerrain(n terrain.Terrain_e) error {
 	// ignore the new terrain if it is blank or the same as the existing terrain
 	if n == terrain.Blank || n == t.Terrain {
-		return
+		return nil
 	}
 	// always accept if the current terrain is blank
 	if t.Terrain == terrain.Blank {
 		t.Terrain = n
-		return
+		return nil
 	}
 
 	// at this point, we know that t.Terrain != terrain.Blank.
 	// we want to make sure that we don't overwrite the terrain with a fleet observation.
 	isFleetObservation := n == terrain.UnknownLand || n == terrain.UnknownWater
 	if isFleetObservation {
-		return
+		return nil
+	}
+	// a fleet observation is always replaced by the actual terrain.
+	if t.Terrain == terrain.UnknownLand || t.Terrain == terrain.UnknownWater {
+		t.Terrain = n
+		return nil
 	}
 
-	// log any deltas
-	log.Printf("useless tidbit: old terrain %-4q new terrain %q\n", t.Terrain, n)
+	// report any deltas
+	err := fmt.Errorf("%s: %w: old terrain %q, new terrain %q", t.Location.GridString(), cerrs.ErrTerrainConflict, t.Terrain, n)
 
 	t.Terrain = n
+
+	return err
+}
+
+// MergeWind merges a wind report into the tile.
+// A fleet reports the same wind for every hex it sails through in a turn,
+// so only one wind per turn and fleet is kept.
+func (t *Tile_t) MergeWind(w *parser.Wind_t) {
+	if w == nil {
+		return
+	}
+	for _, l := range t.Winds {
+		if l.TurnId == w.TurnId && l.UnitId == w.UnitId {
+			return
+		}
+	}
+	t.Winds = append(t.Winds, w)
 }
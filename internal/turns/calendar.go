@@ -0,0 +1,93 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package turns
+
+import (
+	"fmt"
+	"github.com/mdhender/ottomap/internal/diagnostics"
+	"github.com/mdhender/ottomap/internal/parser"
+	"strconv"
+	"strings"
+)
+
+// CheckCalendar makes sure that consecutive turns agree with each other.
+// The next turn reported in one turn should be the id of the turn that follows it.
+// The input must be sorted by turn.
+//
+// A warning is added to the diagnostics for each gap, and the ids of the
+// missing turns are returned.
+func CheckCalendar(input []*parser.Turn_t, dx *diagnostics.Collector_t) (missing []string) {
+	for n := 1; n < len(input); n++ {
+		prev, turn := input[n-1], input[n]
+		expected := prev.ExpectedNextTurnId()
+		if expected == turn.Id {
+			continue
+		}
+		if !isTurnId(expected) || !isTurnId(turn.Id) {
+			// we can't count the turns between invalid ids
+			dx.Add(&diagnostics.Diagnostic_t{
+				Severity: diagnostics.Warning,
+				TurnId:   turn.Id,
+				Message:  fmt.Sprintf("next turn is %q, but the next report is for turn %q", expected, turn.Id),
+				Fix:      "turn ids must be yyyy-mm, for example \"0900-01\"",
+			})
+			continue
+		}
+		var gap []string
+		for id := expected; id != "" && id < turn.Id; id = nextTurnId(id) {
+			gap = append(gap, id)
+		}
+		missing = append(missing, gap...)
+		message := fmt.Sprintf("next turn is %q, but the next report is for turn %q", expected, turn.Id)
+		fix := "add the reports for the missing turns to the input folder"
+		if len(gap) == 0 {
+			fix = "make sure the report file names match the turns in the reports"
+		} else if len(gap) == 1 {
+			message = fmt.Sprintf("missing turn %q", gap[0])
+		} else {
+			message = fmt.Sprintf("missing turns %q through %q", gap[0], gap[len(gap)-1])
+		}
+		dx.Add(&diagnostics.Diagnostic_t{
+			Severity: diagnostics.Warning,
+			TurnId:   turn.Id,
+			Message:  message,
+			Fix:      fix,
+		})
+	}
+	return missing
+}
+
+// nextTurnId returns the id of the month after the turn.
+// It returns an empty string if the id is not valid.
+func nextTurnId(id string) string {
+	year, month, err := ParseTurnId(id)
+	if err != nil {
+		return ""
+	}
+	t := parser.Turn_t{Year: year, Month: month}
+	return t.ExpectedNextTurnId()
+}
+
+// isTurnId returns true if the id is a valid yyyy-mm turn id.
+func isTurnId(id string) bool {
+	_, _, err := ParseTurnId(id)
+	return err == nil
+}
+
+// ParseTurnId returns the year and month from a yyyy-mm turn id.
+// The year must be between 899 and 9999 and the month between 1 and 12.
+func ParseTurnId(id string) (year, month int, err error) {
+	yyyy, mm, ok := strings.Cut(id, "-")
+	if !ok || len(yyyy) != 4 || len(mm) != 2 || strings.Trim(yyyy+mm, "0123456789") != "" {
+		return 0, 0, fmt.Errorf("must be yyyy-mm format")
+	} else if year, err = strconv.Atoi(yyyy); err != nil {
+		return 0, 0, fmt.Errorf("must be yyyy-mm format")
+	} else if month, err = strconv.Atoi(mm); err != nil {
+		return 0, 0, fmt.Errorf("must be yyyy-mm format")
+	} else if year < 899 || year > 9999 {
+		return 0, 0, fmt.Errorf("invalid year %d", year)
+	} else if month < 1 || month > 12 {
+		return 0, 0, fmt.Errorf("invalid month %d", month)
+	}
+	return year, month, nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package turns_test
+
+import (
+	"github.com/go-test/deep"
+	"github.com/mdhender/ottomap/internal/diagnostics"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/turns"
+	"testing"
+)
+
+func TestCheckCalendar(t *testing.T) {
+	for _, tc := range []struct {
+		id       string
+		input    []*parser.Turn_t
+		missing  []string
+		warnings int
+	}{
+		{id: "year rollover",
+			input: []*parser.Turn_t{{Id: "0899-12", Year: 899, Month: 12}, {Id: "0900-01", Year: 900, Month: 1}},
+		},
+		{id: "next turn from the report",
+			input: []*parser.Turn_t{{Id: "0899-12", Year: 899, Month: 12, NextTurnId: "0900-01"}, {Id: "0900-01", Year: 900, Month: 1}},
+		},
+		{id: "missing turn",
+			input:    []*parser.Turn_t{{Id: "0900-01", Year: 900, Month: 1}, {Id: "0900-03", Year: 900, Month: 3}},
+			missing:  []string{"0900-02"},
+			warnings: 1,
+		},
+		{id: "missing turns across the year",
+			input:    []*parser.Turn_t{{Id: "0900-11", Year: 900, Month: 11}, {Id: "0901-02", Year: 901, Month: 2}},
+			missing:  []string{"0900-12", "0901-01"},
+			warnings: 1,
+		},
+		{id: "duplicated turn",
+			input:    []*parser.Turn_t{{Id: "0900-01", Year: 900, Month: 1}, {Id: "0900-01", Year: 900, Month: 1}},
+			warnings: 1,
+		},
+		{id: "malformed next turn",
+			input:    []*parser.Turn_t{{Id: "0900-01", Year: 900, Month: 1, NextTurnId: "900-2"}, {Id: "0900-02", Year: 900, Month: 2}},
+			warnings: 1,
+		},
+		{id: "malformed turn",
+			input:    []*parser.Turn_t{{Id: "0900-01", Year: 900, Month: 1}, {Id: "900-2", Year: 900, Month: 2}},
+			warnings: 1,
+		},
+	} {
+		dx := diagnostics.New()
+		missing := turns.CheckCalendar(tc.input, dx)
+		for _, d := range deep.Equal(tc.missing, missing) {
+			t.Errorf("%s: missing: %s\n", tc.id, d)
+		}
+		if dx.Warnings() != tc.warnings {
+			t.Errorf("%s: warnings: want %d, got %d\n", tc.id, tc.warnings, dx.Warnings())
+		}
+	}
+}
+
+func TestExpectedNextTurnId(t *testing.T) {
+	for _, tc := range []struct {
+		turn *parser.Turn_t
+		want string
+	}{
+		{turn: &parser.Turn_t{Year: 899, Month: 12}, want: "0900-01"},
+		{turn: &parser.Turn_t{Year: 900, Month: 1}, want: "0900-02"},
+		{turn: &parser.Turn_t{Year: 900, Month: 11}, want: "0900-12"},
+		{turn: &parser.Turn_t{Year: 900, Month: 1, NextTurnId: "0900-03"}, want: "0900-03"}, // the report wins
+	} {
+		if got := tc.turn.ExpectedNextTurnId(); got != tc.want {
+			t.Errorf("%04d-%02d: want %q, got %q\n", tc.turn.Year, tc.turn.Month, tc.want, got)
+		}
+	}
+}
+
+func TestParseTurnId(t *testing.T) {
+	for _, tc := range []struct {
+		id    string
+		year  int
+		month int
+		ok    bool
+	}{
+		{id: "0899-12", year: 899, month: 12, ok: true},
+		{id: "0900-01", year: 900, month: 1, ok: true},
+		{id: "9999-12", year: 9999, month: 12, ok: true},
+		{id: "0898-12"},
+		{id: "0900-00"},
+		{id: "0900-13"},
+		{id: "900-01"},
+		{id: "0900-1"},
+		{id: "0900+01"},
+		{id: "0900-+1"},
+		{id: ""},
+	} {
+		year, month, err := turns.ParseTurnId(tc.id)
+		if !tc.ok {
+			if err == nil {
+				t.Errorf("%q: want error, got %d-%d\n", tc.id, year, month)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: want %d-%d, got %v\n", tc.id, tc.year, tc.month, err)
+		} else if year != tc.year || month != tc.month {
+			t.Errorf("%q: want %d-%d, got %d-%d\n", tc.id, tc.year, tc.month, year, month)
+		}
+	}
+}
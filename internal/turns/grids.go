@@ -0,0 +1,270 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package turns
+
+import (
+	"fmt"
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/diagnostics"
+	"github.com/mdhender/ottomap/internal/parser"
+	"log"
+	"strconv"
+	"strings"
+)
+
+// InferGrids replaces the obscured grid ("##") in unit locations with the true grid.
+//
+// Early turn reports obscure the grid, so locations look like "## 1304".
+// For each clan, we find the first location of the clan that isn't obscured
+// and follow the clan's path back to its first turn. Between two locations,
+// we assume that the clan moved the shortest distance, so the grid changes
+// when the digits wrap around the edge of the grid. Every other unit is put
+// in the grid that is closest to its clan's location in the same turn.
+//
+// If a clan's location is always obscured, originGrid is used for the clan's
+// first location. If originGrid is empty, an error is added to the diagnostics.
+// The input must be sorted by turn and the links between turns must be checked.
+//
+// It returns the number of locations that were updated.
+func InferGrids(input []*parser.Turn_t, originGrid string, dx *diagnostics.Collector_t) (updated int) {
+	// find the clans that have obscured locations
+	var clans []string
+	obscured := map[string]bool{}
+	for _, turn := range input {
+		for _, moves := range turn.SortedMoves {
+			if strings.HasPrefix(moves.FromHex, "##") || strings.HasPrefix(moves.ToHex, "##") {
+				if clan := reportClan(moves); !obscured[clan] {
+					obscured[clan] = true
+					clans = append(clans, clan)
+				}
+			}
+		}
+	}
+
+	for _, clan := range clans {
+		frames, ok := clanFrames(input, clan, originGrid, dx)
+		if !ok {
+			continue
+		}
+		for n, turn := range input {
+			for _, moves := range turn.SortedMoves {
+				if reportClan(moves) != clan {
+					continue
+				}
+				// the unit starts near the clan's starting hex and ends near the clan's ending hex
+				hexes := []*string{&moves.FromHex, &moves.ToHex, &moves.GoesTo}
+				for _, move := range moves.Moves {
+					hexes = append(hexes, &move.GoesTo)
+				}
+				for i, hex := range hexes {
+					anchor := frames[n].to
+					if i == 0 {
+						anchor = frames[n].from
+					}
+					if ok, err := replaceGrid(hex, anchor); err != nil {
+						dx.Add(&diagnostics.Diagnostic_t{
+							Severity: diagnostics.Error,
+							ReportId: moves.ReportId,
+							TurnId:   turn.Id,
+							UnitId:   string(moves.Id),
+							Message:  fmt.Sprintf("obscured hex %q: %v", *hex, err),
+							Fix:      "replace the \"##\" in the unit's location with the grid; see docs/ERRORS.md",
+						})
+					} else if ok {
+						updated++
+					}
+				}
+			}
+		}
+	}
+
+	if updated != 0 {
+		log.Printf("grids: updated %8d obscured locations\n", updated)
+	}
+	return updated
+}
+
+// frame_t is the location of the clan at the start and end of a turn.
+type frame_t struct {
+	from, to coords.Map
+}
+
+// clanFrames returns the location of the clan for every turn in the input.
+// It returns false if the locations can't be found; the problem has been
+// added to the diagnostics.
+func clanFrames(input []*parser.Turn_t, clan, originGrid string, dx *diagnostics.Collector_t) ([]frame_t, bool) {
+	// collect the clan's path as a list of hexes, two per turn
+	type point_t struct {
+		turn     int
+		hex      string
+		location coords.Map
+	}
+	var path []*point_t
+	for n, turn := range input {
+		if moves := turn.UnitMoves[parser.UnitId_t(clan)]; moves != nil && reportClan(moves) == clan {
+			if moves.FromHex != "N/A" {
+				path = append(path, &point_t{turn: n, hex: moves.FromHex})
+			}
+			path = append(path, &point_t{turn: n, hex: moves.ToHex})
+		}
+	}
+	if len(path) == 0 {
+		dx.Add(&diagnostics.Diagnostic_t{
+			Severity: diagnostics.Error,
+			ReportId: fmt.Sprintf("%s.%s", input[0].Id, clan),
+			UnitId:   clan,
+			Message:  fmt.Sprintf("clan %s: can't infer the grid for obscured locations: the clan is not in the reports", clan),
+			Fix:      "add the clan's section to the report",
+		})
+		return nil, false
+	}
+
+	// the anchor is the first location that isn't obscured
+	anchor := -1
+	for n, point := range path {
+		if !strings.HasPrefix(point.hex, "##") {
+			location, err := coords.HexToMap(point.hex)
+			if err != nil {
+				continue
+			}
+			anchor, point.location = n, location
+			break
+		}
+	}
+	if anchor != -1 {
+		for n := anchor - 1; n >= 0; n-- {
+			location, err := nearest(path[n+1].location, path[n].hex)
+			if err != nil {
+				return nil, failClan(input, clan, path[n].turn, path[n].hex, err, dx)
+			}
+			path[n].location = location
+		}
+		dx.Add(&diagnostics.Diagnostic_t{
+			Severity: diagnostics.Info,
+			ReportId: fmt.Sprintf("%s.%s", input[path[0].turn].Id, clan),
+			TurnId:   input[path[0].turn].Id,
+			UnitId:   clan,
+			Message:  fmt.Sprintf("obscured grid inferred as %q from the clan's hex %q in turn %s", path[0].location.GridId(), path[anchor].hex, input[path[anchor].turn].Id),
+		})
+		log.Printf("grids: clan %s: inferred %q from %q in turn %s\n", clan, path[0].location.GridId(), path[anchor].hex, input[path[anchor].turn].Id)
+	} else if originGrid == "" {
+		dx.Add(&diagnostics.Diagnostic_t{
+			Severity: diagnostics.Error,
+			ReportId: fmt.Sprintf("%s.%s", input[path[0].turn].Id, clan),
+			TurnId:   input[path[0].turn].Id,
+			UnitId:   clan,
+			Message:  fmt.Sprintf("clan %s: can't infer the grid for obscured locations: every location is obscured", clan),
+			Fix:      "run with --origin-grid or replace the \"##\" in the clan's location with the grid; see docs/ERRORS.md",
+		})
+		return nil, false
+	} else {
+		location, err := coords.HexToMap(originGrid + path[0].hex[2:])
+		if err != nil {
+			return nil, failClan(input, clan, path[0].turn, path[0].hex, err, dx)
+		}
+		anchor, path[0].location = 0, location
+		dx.Add(&diagnostics.Diagnostic_t{
+			Severity: diagnostics.Warning,
+			ReportId: fmt.Sprintf("%s.%s", input[path[0].turn].Id, clan),
+			TurnId:   input[path[0].turn].Id,
+			UnitId:   clan,
+			Message:  fmt.Sprintf("obscured grid assumed to be %q from the origin grid", originGrid),
+			Fix:      "the map will move when a report shows the true grid",
+		})
+		log.Printf("grids: clan %s: assumed %q from the origin grid\n", clan, originGrid)
+	}
+	for n := anchor + 1; n < len(path); n++ {
+		if !strings.HasPrefix(path[n].hex, "##") {
+			if location, err := coords.HexToMap(path[n].hex); err == nil {
+				path[n].location = location
+				continue
+			}
+		}
+		location, err := nearest(path[n-1].location, path[n].hex)
+		if err != nil {
+			return nil, failClan(input, clan, path[n].turn, path[n].hex, err, dx)
+		}
+		path[n].location = location
+	}
+
+	// turns without the clan use the clan's last location, or its first location if there isn't one
+	frames := make([]frame_t, len(input))
+	last := path[0].location
+	for n, next := 0, 0; n < len(input); n++ {
+		frames[n] = frame_t{from: last, to: last}
+		for first := true; next < len(path) && path[next].turn == n; next, first = next+1, false {
+			if first {
+				frames[n].from = path[next].location
+			}
+			frames[n].to, last = path[next].location, path[next].location
+		}
+	}
+	return frames, true
+}
+
+// failClan adds an error for a clan location that can't be placed.
+func failClan(input []*parser.Turn_t, clan string, turn int, hex string, err error, dx *diagnostics.Collector_t) bool {
+	dx.Add(&diagnostics.Diagnostic_t{
+		Severity: diagnostics.Error,
+		ReportId: fmt.Sprintf("%s.%s", input[turn].Id, clan),
+		TurnId:   input[turn].Id,
+		UnitId:   clan,
+		Message:  fmt.Sprintf("clan %s: hex %q: %v", clan, hex, err),
+		Fix:      "replace the \"##\" in the clan's location with the grid; see docs/ERRORS.md",
+	})
+	return false
+}
+
+// nearest returns the location of the obscured hex that is closest to the anchor.
+func nearest(anchor coords.Map, hex string) (coords.Map, error) {
+	if len(hex) != 7 || hex[2] != ' ' {
+		return coords.Map{}, fmt.Errorf("invalid location")
+	}
+	column, err := strconv.Atoi(hex[3:5])
+	if err != nil || column < 1 || column > 30 {
+		return coords.Map{}, fmt.Errorf("invalid column")
+	}
+	row, err := strconv.Atoi(hex[5:])
+	if err != nil || row < 1 || row > 21 {
+		return coords.Map{}, fmt.Errorf("invalid row")
+	}
+	location := coords.Map{
+		Column: (anchor.Column/30)*30 + column - 1,
+		Row:    (anchor.Row/21)*21 + row - 1,
+	}
+	// the digits wrap when a unit crosses into the next grid
+	if location.Column-anchor.Column > 15 {
+		location.Column -= 30
+	} else if anchor.Column-location.Column > 15 {
+		location.Column += 30
+	}
+	if location.Row-anchor.Row > 10 {
+		location.Row -= 21
+	} else if anchor.Row-location.Row > 10 {
+		location.Row += 21
+	}
+	if location.Column < 0 || location.Row < 0 {
+		return coords.Map{}, fmt.Errorf("off the edge of the map")
+	}
+	return location, nil
+}
+
+// replaceGrid replaces an obscured location with the one closest to the anchor.
+// It returns true if the location was updated.
+func replaceGrid(hex *string, anchor coords.Map) (bool, error) {
+	if !strings.HasPrefix(*hex, "##") {
+		return false, nil
+	}
+	location, err := nearest(anchor, *hex)
+	if err != nil {
+		return false, err
+	}
+	*hex = location.ToHex()
+	return true, nil
+}
+
+// reportClan returns the clan from the id of the report the moves came from.
+func reportClan(moves *parser.Moves_t) string {
+	_, clan, _ := strings.Cut(moves.ReportId, ".")
+	return clan
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package turns_test
+
+import (
+	"github.com/mdhender/ottomap/internal/diagnostics"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/turns"
+	"testing"
+)
+
+func TestInferGrids(t *testing.T) {
+	// the clan crosses from grid MG into MH while the grid is obscured
+	clan1 := &parser.Moves_t{Id: "0991", FromHex: "## 3010", ToHex: "## 0110"}
+	element1 := &parser.Moves_t{Id: "0991e1", FromHex: "## 2910", ToHex: "## 2909", GoesTo: "## 2909"}
+	clan2 := &parser.Moves_t{Id: "0991", FromHex: "## 0110", ToHex: "MH 0210"}
+	input := []*parser.Turn_t{newTurn("0901-01", clan1, element1), newTurn("0901-02", clan2)}
+
+	dx := diagnostics.New()
+	if n := turns.InferGrids(input, "", dx); n != 6 {
+		t.Errorf("updated: want 6, got %d", n)
+	}
+	if dx.Errors() != 0 {
+		t.Errorf("errors: want 0, got %d", dx.Errors())
+	}
+	for _, tc := range []struct {
+		what      string
+		got, want string
+	}{
+		{"clan from", clan1.FromHex, "MG 3010"},
+		{"clan to", clan1.ToHex, "MH 0110"},
+		{"element from", element1.FromHex, "MG 2910"},
+		{"element to", element1.ToHex, "MG 2909"},
+		{"element goes to", element1.GoesTo, "MG 2909"},
+		{"clan from next turn", clan2.FromHex, "MH 0110"},
+	} {
+		if tc.got != tc.want {
+			t.Errorf("%s: want %q, got %q", tc.what, tc.want, tc.got)
+		}
+	}
+
+	// without a location that isn't obscured, the origin grid is required
+	input = []*parser.Turn_t{newTurn("0901-01", &parser.Moves_t{Id: "0991", FromHex: "## 1304", ToHex: "## 1305"})}
+	dx = diagnostics.New()
+	if turns.InferGrids(input, "", dx); dx.Errors() != 1 {
+		t.Errorf("no origin: errors: want 1, got %d", dx.Errors())
+	}
+	dx = diagnostics.New()
+	if turns.InferGrids(input, "KK", dx); dx.Errors() != 0 {
+		t.Errorf("origin: errors: want 0, got %d", dx.Errors())
+	} else if got := input[0].SortedMoves[0].ToHex; got != "KK 1305" {
+		t.Errorf("origin: want %q, got %q", "KK 1305", got)
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package turns_test
+
+import (
+	"github.com/mdhender/ottomap/internal/parser"
+)
+
+// newTurn returns a turn with the units in the order given.
+// The units are updated with the turn and report ids.
+func newTurn(id string, units ...*parser.Moves_t) *parser.Turn_t {
+	turn := &parser.Turn_t{Id: id, UnitMoves: map[parser.UnitId_t]*parser.Moves_t{}}
+	for _, unit := range units {
+		unit.TurnId, unit.ReportId = id, id+".0991"
+		turn.UnitMoves[unit.Id] = unit
+		turn.SortedMoves = append(turn.SortedMoves, unit)
+	}
+	return turn
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package turns
+
+import (
+	"github.com/mdhender/ottomap/internal/direction"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/results"
+	"github.com/mdhender/ottomap/internal/terrain"
+)
+
+// UnitTurn_t is the movement of a single unit in one turn.
+type UnitTurn_t struct {
+	TurnId   string         `json:"turn"`
+	ReportId string         `json:"report"`
+	Start    string         `json:"start"` // hex the unit started the turn in
+	End      string         `json:"end"`   // hex the unit ended the turn in
+	Follows  string         `json:"follows,omitempty"`
+	GoesTo   string         `json:"goesTo,omitempty"`
+	Steps    []*UnitStep_t  `json:"steps,omitempty"`
+	Scouts   []*UnitScout_t `json:"scouts,omitempty"`
+}
+
+// UnitStep_t is a single step of a unit or scout.
+type UnitStep_t struct {
+	LineNo    int                   `json:"line"`
+	StepNo    int                   `json:"step"`
+	Direction direction.Direction_e `json:"direction,omitempty"` // set only if the unit is advancing
+	Follows   string                `json:"follows,omitempty"`
+	GoesTo    string                `json:"goesTo,omitempty"`
+	Still     bool                  `json:"still,omitempty"`
+	Result    results.Result_e      `json:"result"`
+	Hex       string                `json:"hex,omitempty"` // hex the unit is in after the step
+	Terrain   terrain.Terrain_e     `json:"terrain,omitempty"`
+}
+
+// UnitScout_t is the path of one scouting party sent out by the unit.
+type UnitScout_t struct {
+	No    int           `json:"no"`
+	Steps []*UnitStep_t `json:"steps,omitempty"`
+}
+
+// UnitHistory returns the movement of the unit for every turn it reports in.
+// The input should have been walked so that the locations are set; otherwise
+// the hexes are taken from the report.
+func UnitHistory(input []*parser.Turn_t, id parser.UnitId_t) (history []*UnitTurn_t) {
+	for _, turn := range input {
+		moves := turn.UnitMoves[id]
+		if moves == nil {
+			continue
+		}
+		ut := &UnitTurn_t{
+			TurnId:   turn.Id,
+			ReportId: moves.ReportId,
+			Start:    moves.FromHex,
+			End:      moves.ToHex,
+			Follows:  string(moves.Follows),
+			GoesTo:   moves.GoesTo,
+		}
+		if moves.StartHex != "" {
+			ut.Start = moves.StartHex
+		}
+		if !moves.Location.IsZero() {
+			ut.End = moves.Location.ToHex()
+		}
+		for _, move := range moves.Moves {
+			ut.Steps = append(ut.Steps, historyStep(move))
+		}
+		for _, scout := range moves.Scouts {
+			us := &UnitScout_t{No: scout.No}
+			for _, move := range scout.Moves {
+				us.Steps = append(us.Steps, historyStep(move))
+			}
+			ut.Scouts = append(ut.Scouts, us)
+		}
+		history = append(history, ut)
+	}
+	return history
+}
+
+func historyStep(move *parser.Move_t) *UnitStep_t {
+	step := &UnitStep_t{
+		LineNo:    move.LineNo,
+		StepNo:    move.StepNo,
+		Direction: move.Advance,
+		Follows:   string(move.Follows),
+		GoesTo:    move.GoesTo,
+		Still:     move.Still,
+		Result:    move.Result,
+	}
+	if !move.Location.IsZero() {
+		step.Hex = move.Location.ToHex()
+	}
+	if move.Report != nil {
+		step.Terrain = move.Report.Terrain
+	}
+	return step
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package turns_test
+
+import (
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/diagnostics"
+	"github.com/mdhender/ottomap/internal/direction"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/results"
+	"github.com/mdhender/ottomap/internal/terrain"
+	"github.com/mdhender/ottomap/internal/turns"
+	"testing"
+)
+
+func TestUnitHistory(t *testing.T) {
+	step := func(lineNo, stepNo int, d direction.Direction_e, r results.Result_e) *parser.Move_t {
+		return &parser.Move_t{Advance: d, Result: r, LineNo: lineNo, StepNo: stepNo, Report: &parser.Report_t{Terrain: terrain.Prairie}}
+	}
+	clan1 := &parser.Moves_t{Id: "0991", FromHex: "MH 0714", ToHex: "MH 0814", Moves: []*parser.Move_t{step(10, 1, direction.SouthEast, results.Succeeded), step(10, 2, direction.South, results.Failed)}}
+	clan1.Scouts = []*parser.Scout_t{{No: 1, Moves: []*parser.Move_t{step(12, 1, direction.North, results.Succeeded)}}}
+	element := &parser.Moves_t{Id: "0991e1", FromHex: "N/A", ToHex: "MH 0813", Moves: []*parser.Move_t{step(20, 1, direction.North, results.Succeeded)}}
+	clan2 := &parser.Moves_t{Id: "0991", FromHex: "MH 0814", ToHex: "MH 0814", Moves: []*parser.Move_t{{Still: true, Result: results.StatusLine, LineNo: 30, Report: &parser.Report_t{}}}}
+	input := []*parser.Turn_t{newTurn("0901-01", clan1), newTurn("0901-02", clan2, element)}
+
+	dx := diagnostics.New()
+	if _, err := turns.Walk(input, nil, map[parser.UnitId_t]coords.Map{}, "", true, false, false, dx); err != nil {
+		dx.Log()
+		t.Fatalf("walk: %v", err)
+	}
+
+	history := turns.UnitHistory(input, "0991")
+	if len(history) != 2 {
+		t.Fatalf("clan: turns: want 2, got %d", len(history))
+	}
+	if got := history[0]; got.Start != "MH 0714" || got.End != "MH 0814" {
+		t.Errorf("clan: turn 1: want MH 0714 -> MH 0814, got %s -> %s", got.Start, got.End)
+	}
+	for n, want := range []string{"MH 0814", "MH 0814"} {
+		if got := history[0].Steps[n].Hex; got != want {
+			t.Errorf("clan: step %d: want %q, got %q", n+1, want, got)
+		}
+	}
+	if len(history[0].Scouts) != 1 || history[0].Scouts[0].Steps[0].Hex != "MH 0813" {
+		t.Errorf("clan: scout 1: want MH 0813")
+	}
+
+	// the walk replaces the "N/A" with the hex the unit was created in
+	history = turns.UnitHistory(input, "0991e1")
+	if len(history) != 1 {
+		t.Fatalf("element: turns: want 1, got %d", len(history))
+	} else if got := history[0]; got.Start != "MH 0814" || got.End != "MH 0813" {
+		t.Errorf("element: want MH 0814 -> MH 0813, got %s -> %s", got.Start, got.End)
+	}
+
+	if history = turns.UnitHistory(input, "0991e2"); len(history) != 0 {
+		t.Errorf("missing unit: turns: want 0, got %d", len(history))
+	}
+}
@@ -22,7 +22,7 @@ func CollectInputs(path string, maxYear, maxMonth int) (inputs []*TurnReportFile
 
 	entries, err := os.ReadDir(path)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 	for _, entry := range entries {
 		if !entry.IsDir() {
@@ -36,7 +36,7 @@ func CollectInputs(path string, maxYear, maxMonth int) (inputs []*TurnReportFile
 			month, _ := strconv.Atoi(matches[2])
 			clanId := matches[3]
 			if year < 899 || year > 9999 || month < 1 || month > 12 {
-				log.Printf("warn: %q: invalid turn year or month\n")
+				log.Printf("warn: %q: invalid turn year or month\n", fileName)
 				continue
 			}
 			pastCutoff := false
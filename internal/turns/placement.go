@@ -0,0 +1,113 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package turns
+
+import (
+	"fmt"
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/direction"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/results"
+	"strings"
+)
+
+// placement_t is the hex a new unit was created in.
+type placement_t struct {
+	location coords.Map
+	note     string // set when the report's Previous Hex was not used
+}
+
+// placeNewUnit finds the hex that a unit created this turn started in.
+//
+// Units can be created before or after the parent moves, so the unit could
+// start in any hex the parent was in during the turn. We use the unit's
+// Current Hex and undo its moves to find the hex it started in, then check
+// that the parent was in that hex. When that isn't possible (the unit
+// followed or went to another unit), we use the unit's Previous Hex or,
+// if the parent never left its hex, the parent's hex.
+//
+// It returns an error when the hex is ambiguous or doesn't match the
+// parent's path; the user must update the Previous Hex in the report.
+func placeNewUnit(unit *parser.Moves_t, parentPath []coords.Map) (placement_t, error) {
+	onPath := func(location coords.Map) bool {
+		for _, hex := range parentPath {
+			if hex == location {
+				return true
+			}
+		}
+		return false
+	}
+
+	var previous coords.Map
+	if unit.FromHex != "N/A" && !strings.HasPrefix(unit.FromHex, "##") {
+		previous, _ = coords.HexToMap(unit.FromHex)
+	}
+
+	if start, ok := startingHex(unit); ok {
+		if onPath(start) {
+			if previous.IsZero() {
+				return placement_t{location: start, note: fmt.Sprintf("previous hex %q: created in %q", unit.FromHex, start.ToHex())}, nil
+			} else if previous != start {
+				return placement_t{location: start, note: fmt.Sprintf("previous hex %q: created in %q after the parent moved", unit.FromHex, start.ToHex())}, nil
+			}
+			return placement_t{location: start}, nil
+		} else if !previous.IsZero() && onPath(previous) {
+			// the moves don't add up, so trust the report
+			return placement_t{location: previous}, nil
+		}
+		return placement_t{}, fmt.Errorf("started in %q, but parent %q was not in that hex", start.ToHex(), unit.Id.Parent())
+	}
+
+	if !previous.IsZero() {
+		return placement_t{location: previous}, nil
+	}
+	// the parent may have stayed in one hex for the entire turn
+	for _, hex := range parentPath[1:] {
+		if hex != parentPath[0] {
+			return placement_t{}, fmt.Errorf("ambiguous: parent %q was in more than one hex this turn", unit.Id.Parent())
+		}
+	}
+	return placement_t{location: parentPath[0], note: fmt.Sprintf("previous hex %q: created in %q", unit.FromHex, parentPath[0].ToHex())}, nil
+}
+
+// startingHex undoes the unit's moves from its Current Hex to find the hex
+// it started the turn in. It returns false if the unit followed or went to
+// another unit, or if the Current Hex isn't known.
+func startingHex(unit *parser.Moves_t) (coords.Map, bool) {
+	if unit.Follows != "" || unit.GoesTo != "" || strings.HasPrefix(unit.ToHex, "##") {
+		return coords.Map{}, false
+	}
+	location, err := coords.HexToMap(unit.ToHex)
+	if err != nil {
+		return coords.Map{}, false
+	}
+	for n := len(unit.Moves) - 1; n >= 0; n-- {
+		move := unit.Moves[n]
+		if move.Follows != "" || move.GoesTo != "" {
+			return coords.Map{}, false
+		} else if move.Still || move.Result != results.Succeeded {
+			continue
+		}
+		location = location.Add(direction.Opposite[move.Advance])
+	}
+	return location, true
+}
+
+// parentPath returns the hexes the parent was in during the turn: the hex
+// it started in followed by the hex after each step.
+func parentPath(parent *parser.Moves_t, start coords.Map) []coords.Map {
+	path := []coords.Map{start}
+	location := start
+	for _, move := range parent.Moves {
+		if move.Follows != "" || move.GoesTo != "" {
+			// the parent ended the turn with another unit or in another hex
+			if end, err := coords.HexToMap(parent.ToHex); err == nil {
+				location = end
+			}
+		} else if !move.Still && move.Result == results.Succeeded {
+			location = location.Add(move.Advance)
+		}
+		path = append(path, location)
+	}
+	return path
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/mdhender/ottomap/internal/parser"
 	"github.com/mdhender/ottomap/internal/results"
 	"github.com/mdhender/ottomap/internal/tiles"
-	"log"
 )
 
 // Step processes a single step from a unit's move.
@@ -60,9 +59,7 @@ func Step(turnId string, move *parser.Move_t, location, leader coords.Map, world
 			return location, err
 		}
 	} else {
-		log.Printf("%s: %d: step %d: result %q\n", turnId, move.LineNo, move.StepNo, move.Result)
-		log.Printf("line: %q\n", move.Line)
-		panic(fmt.Sprintf("assert(result != %q)", move.Result))
+		return location, fmt.Errorf("step %d: unexpected result %q", move.StepNo, move.Result)
 	}
 	if to == nil {
 		panic("missing tile")
@@ -101,8 +98,7 @@ func stepGoto(turnId string, move *parser.Move_t, from *tiles.Tile_t, goesTo str
 	// unit is going to a specific location, so update the location to that location
 	location, err := coords.HexToMap(goesTo)
 	if err != nil {
-		log.Printf("walk: %s: %d: %s: %v\n", turnId, move.LineNo, move.GoesTo, err)
-		panic(err)
+		return nil, fmt.Errorf("goes to %q: %w", goesTo, err)
 	}
 	// update current hex based on the destination's location
 	to := worldMap.FetchTile(location)
@@ -3,7 +3,11 @@
 package turns
 
 import (
+	"errors"
+	"fmt"
+	"github.com/mdhender/ottomap/cerrs"
 	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/diagnostics"
 	"github.com/mdhender/ottomap/internal/parser"
 	"github.com/mdhender/ottomap/internal/tiles"
 	"log"
@@ -11,31 +15,93 @@ import (
 	"time"
 )
 
-func Walk(input []*parser.Turn_t, originGrid string, quitOnInvalidGrid, warnOnInvalidGrid, debug bool) (*tiles.Map_t, error) {
+// Walk walks the turns and updates the world map with the results.
+// The world map and last seen locations may be nil, in which case the walk starts from scratch.
+// Otherwise, the walk resumes from them (for example, when they were loaded from an atlas)
+// and lastSeen is updated in place.
+//
+// Problems are added to the diagnostics collector. Units with errors are skipped
+// so that the walk can report the problems for every unit in a single pass.
+func Walk(input []*parser.Turn_t, worldMap *tiles.Map_t, lastSeen map[parser.UnitId_t]coords.Map, originGrid string, quitOnInvalidGrid, warnOnInvalidGrid, debug bool, dx *diagnostics.Collector_t) (*tiles.Map_t, error) {
 	started := time.Now()
 	log.Printf("walk: input: %8d turns\n", len(input))
 
 	// last seen is a map containing the last seen location for each unit
-	lastSeen := map[parser.UnitId_t]coords.Map{}
+	if lastSeen == nil {
+		lastSeen = map[parser.UnitId_t]coords.Map{}
+	}
+
+	if worldMap == nil {
+		worldMap = tiles.NewMap()
+	}
+
+	errorCount := 0
+	fail := func(moves *parser.Moves_t, lineNo int, message, fix string) {
+		errorCount++
+		dx.Add(&diagnostics.Diagnostic_t{
+			Severity: diagnostics.Error,
+			ReportId: moves.ReportId,
+			TurnId:   moves.TurnId,
+			UnitId:   string(moves.Id),
+			Line:     lineNo,
+			Message:  message,
+			Fix:      fix,
+		})
+	}
+	// report step errors. terrain conflicts are only warnings since the newer terrain is kept.
+	stepFailed := func(moves *parser.Moves_t, move *parser.Move_t, err error) bool {
+		var errs []error
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			errs = joined.Unwrap()
+		} else {
+			errs = []error{err}
+		}
+		failed := false
+		for _, err := range errs {
+			if errors.Is(err, cerrs.ErrTerrainConflict) {
+				dx.Add(&diagnostics.Diagnostic_t{
+					Severity: diagnostics.Warning,
+					ReportId: moves.ReportId,
+					TurnId:   moves.TurnId,
+					UnitId:   string(moves.Id),
+					Line:     move.LineNo,
+					Message:  fmt.Sprintf("step %d: %v", move.StepNo, err),
+					Text:     string(move.Line),
+				})
+				continue
+			}
+			failed = true
+			fail(moves, move.LineNo, fmt.Sprintf("step %d: %v", move.StepNo, err), "")
+		}
+		return failed
+	}
 
-	worldMap := tiles.NewMap()
 	for _, turn := range input {
 		// sanity check, these should always be the same value
 		for _, moves := range turn.SortedMoves {
 			if moves.GoesTo != "" && moves.ToHex != moves.GoesTo {
-				unit := moves.Id
-				log.Printf("turn %s: unit %-6s: current hex is %q\n", moves.TurnId, unit, moves.ToHex)
-				log.Printf("turn %s: unit %-6s: goes to hex is %q\n", moves.TurnId, unit, moves.GoesTo)
-				log.Fatalf("error: current hex != goes to hex\n")
+				fail(moves, 0, fmt.Sprintf("current hex %q does not match goes to hex %q", moves.ToHex, moves.GoesTo), "update the unit's Current Hex to match the \"Tribe Goes to\" line")
+			}
+		}
+
+		// units created this turn are placed after we know where their parents were.
+		// we can only tell that a unit is new if we have seen its parent before.
+		created := map[parser.UnitId_t]bool{}
+		for _, unit := range turn.SortedMoves {
+			if _, ok := lastSeen[unit.Id]; ok || unit.Id.Parent() == unit.Id {
+				continue
+			} else if _, ok := lastSeen[unit.Id.Parent()]; ok {
+				created[unit.Id] = true
 			}
 		}
 
 		// leap of faith, update the location of all units that have a valid FromHex
 		for _, unit := range turn.SortedMoves {
-			if !strings.HasPrefix(unit.FromHex, "##") {
+			if created[unit.Id] {
+				continue
+			} else if !strings.HasPrefix(unit.FromHex, "##") {
 				if location, err := coords.HexToMap(unit.FromHex); err != nil {
-					log.Printf("walk: %s: %s: %q: %v\n", turn.Id, unit.Id, unit.FromHex, err)
-					panic(err)
+					fail(unit, 0, fmt.Sprintf("previous hex %q: %v", unit.FromHex, err), "update the unit's Previous Hex with the hex the unit started the turn in")
 				} else {
 					unit.Location, lastSeen[unit.Id] = location, location
 					//log.Printf("walk: turn %s unit %-8s goto %-8s follows %-8s %-8s -> %s\n", turn.Id, unit.Id, unit.GoesTo, unit.Follows, unit.FromHex, unit.Location)
@@ -50,15 +116,39 @@ func Walk(input []*parser.Turn_t, originGrid string, quitOnInvalidGrid, warnOnIn
 			}
 		}
 
-		// update the locations of all units created this turn
-		// note: if the unit was created at the end of the parent's move, this will be wrong.
-		// when that happens, the user must manually update the report to set the correct starting hex.
+		// update the locations of all units created this turn.
+		// the parent could create the unit before or after it moves, so check every hex the parent was in.
 		for _, unit := range turn.SortedMoves {
-			if unit.Location.IsZero() {
+			if !created[unit.Id] {
+				continue
+			}
+			path := []coords.Map{lastSeen[unit.Id.Parent()]}
+			if parent := turn.UnitMoves[unit.Id.Parent()]; parent != nil && !parent.Location.IsZero() {
+				path = parentPath(parent, parent.Location)
+			}
+			placement, err := placeNewUnit(unit, path)
+			if err != nil {
+				fail(unit, 0, fmt.Sprintf("new unit: %v", err), "update the unit's Previous Hex with the hex the unit was created in")
+				continue
+			} else if placement.note != "" {
+				dx.Add(&diagnostics.Diagnostic_t{
+					Severity: diagnostics.Info,
+					ReportId: unit.ReportId,
+					TurnId:   unit.TurnId,
+					UnitId:   string(unit.Id),
+					Message:  fmt.Sprintf("new unit: %s", placement.note),
+				})
+			}
+			unit.Location, lastSeen[unit.Id] = placement.location, placement.location
+		}
+
+		// update the locations of the remaining units from their parents.
+		// these are usually units whose parent was also created this turn.
+		for _, unit := range turn.SortedMoves {
+			if unit.Location.IsZero() && !created[unit.Id] {
 				// it should be an error if we can't derive it from the parent's location
 				if parent, ok := lastSeen[unit.Id.Parent()]; !ok {
-					log.Printf("%s: %-6s: parent %q: missing\n", unit.TurnId, unit.Id, unit.Id.Parent())
-					log.Fatalf("error: expected unit to have parent\n")
+					fail(unit, 0, fmt.Sprintf("expected unit to have parent %q", unit.Id.Parent()), "update the unit's Previous Hex with the hex the unit was created in")
 				} else {
 					//log.Printf("walk: turn %s unit %-8s goto %-8s follows %-8s %-8s -> %s\n", turn.Id, unit.Id, unit.GoesTo, unit.Follows, unit.FromHex, parent)
 					unit.Location, lastSeen[unit.Id] = parent, parent
@@ -72,31 +162,39 @@ func Walk(input []*parser.Turn_t, originGrid string, quitOnInvalidGrid, warnOnIn
 		for _, moves := range turn.SortedMoves {
 			unit := moves.Id
 			//log.Printf("walk: turn %s unit %-8s goto %-8s follows %-8s %-8s    %s\n", turn.Id, unit, moves.GoesTo, moves.Follows, moves.FromHex, moves.Location.GridString())
-			// if we're missing the location, can we derive it from the previous turn?
+			// skip units without a location; the error has already been reported
 			if moves.Location.IsZero() {
-				panic("location is zero")
+				continue
 			}
 
 			var leader coords.Map // set only if this is a follows move
 			if moves.Follows != "" {
 				if location, ok := lastSeen[moves.Follows]; !ok {
-					panic("!!!")
+					fail(moves, 0, fmt.Sprintf("follows %q: unit not found", moves.Follows), "check the unit id on the \"Tribe Follows\" line")
+					continue
 				} else {
 					leader = location
 				}
 			}
 
 			current := moves.Location
+			// the report's Previous Hex may be "N/A" or the parent's hex, so record where the unit really started
+			moves.StartHex = current.ToHex()
 
 			// step through all the moves this unit makes this turn, tracking the location of the unit after each step
+			failed := false
 			for _, move := range moves.Moves {
 				location, err := Step(turn.Id, move, current, leader, worldMap, false, debug)
-				if err != nil {
-					panic(err)
+				if err != nil && stepFailed(moves, move, err) {
+					failed = true
+					break
 				}
 				//log.Printf("%s: %-6s: %d: step %d: result %q: to %q\n", turn.Id, unit, move.LineNo, move.StepNo, move.Result, location)
 				current = location
 			}
+			if failed {
+				continue
+			}
 			moves.Location, lastSeen[unit] = current, current
 
 			// the unit's final location has been updated, so we can now send out the scouting parties
@@ -106,8 +204,8 @@ func Walk(input []*parser.Turn_t, originGrid string, quitOnInvalidGrid, warnOnIn
 				// step through all the moves this scout makes this turn, tracking the location of the scout after each step
 				for _, move := range scout.Moves {
 					location, err := Step(turn.Id, move, current, leader, worldMap, true, debug)
-					if err != nil {
-						panic(err)
+					if err != nil && stepFailed(moves, move, err) {
+						break
 					}
 					//log.Printf("%s: %-6s: %d: step %d: result %q: to %q\n", turn.Id, unit, move.LineNo, move.StepNo, move.Result, location)
 					current = location
@@ -118,5 +216,8 @@ func Walk(input []*parser.Turn_t, originGrid string, quitOnInvalidGrid, warnOnIn
 
 	log.Printf("walk: %8d nodes: elapsed %v\n", len(input), time.Since(started))
 
+	if errorCount != 0 {
+		return worldMap, fmt.Errorf("walk: %d errors", errorCount)
+	}
 	return worldMap, nil
 }
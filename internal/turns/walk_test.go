@@ -0,0 +1,63 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package turns_test
+
+import (
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/diagnostics"
+	"github.com/mdhender/ottomap/internal/direction"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/results"
+	"github.com/mdhender/ottomap/internal/terrain"
+	"github.com/mdhender/ottomap/internal/turns"
+	"testing"
+)
+
+func TestWalkNewUnits(t *testing.T) {
+	step := func(d direction.Direction_e) *parser.Move_t {
+		return &parser.Move_t{Advance: d, Result: results.Succeeded, Report: &parser.Report_t{Terrain: terrain.Prairie}}
+	}
+	still := func() *parser.Move_t {
+		return &parser.Move_t{Still: true, Result: results.Succeeded, Report: &parser.Report_t{Terrain: terrain.Prairie}}
+	}
+	for _, tc := range []struct {
+		id      string
+		element *parser.Moves_t
+		want    string // empty if the placement is ambiguous
+	}{
+		// created after the clan moved; the report gives the clan's starting hex
+		{id: "after-move", element: &parser.Moves_t{Id: "0991e1", FromHex: "MH 0714", ToHex: "MH 0814", Moves: []*parser.Move_t{still()}}, want: "MH 0814"},
+		// created before the clan moved, then moved on its own
+		{id: "before-move", element: &parser.Moves_t{Id: "0991e1", FromHex: "N/A", ToHex: "MH 0713", Moves: []*parser.Move_t{step(direction.North)}}, want: "MH 0714"},
+		// created mid-move, no previous hex
+		{id: "mid-move", element: &parser.Moves_t{Id: "0991e1", FromHex: "N/A", ToHex: "MH 0814"}, want: "MH 0814"},
+		// followed the clan, so only the previous hex can place it
+		{id: "ambiguous", element: &parser.Moves_t{Id: "0991e1", FromHex: "N/A", ToHex: "MH 0914", Follows: "0991", Moves: []*parser.Move_t{{Follows: "0991", Report: &parser.Report_t{}}}}},
+	} {
+		clan1 := &parser.Moves_t{Id: "0991", FromHex: "MH 0714", ToHex: "MH 0714", Moves: []*parser.Move_t{still()}}
+		clan2 := &parser.Moves_t{Id: "0991", FromHex: "MH 0714", ToHex: "MH 0914", Moves: []*parser.Move_t{step(direction.SouthEast), step(direction.NorthEast)}}
+		input := []*parser.Turn_t{newTurn("0901-01", clan1), newTurn("0901-02", clan2, tc.element)}
+		from := tc.element.FromHex
+
+		dx := diagnostics.New()
+		lastSeen := map[parser.UnitId_t]coords.Map{}
+		_, err := turns.Walk(input, nil, lastSeen, "", true, false, false, dx)
+		if tc.want == "" {
+			if err == nil || dx.Errors() != 1 {
+				t.Errorf("%s: want 1 error, got %d", tc.id, dx.Errors())
+			}
+			continue
+		} else if err != nil {
+			dx.Log()
+			t.Errorf("%s: walk: %v", tc.id, err)
+			continue
+		}
+		if tc.element.StartHex != tc.want {
+			t.Errorf("%s: start: want %s, got %s", tc.id, tc.want, tc.element.StartHex)
+		}
+		// the walk must not change the previous hex from the report
+		if tc.element.FromHex != from {
+			t.Errorf("%s: from: want %s, got %s", tc.id, from, tc.element.FromHex)
+		}
+	}
+}
@@ -50,6 +50,7 @@ var (
 	// EnumToString is a helper map for marshalling the enum
 	EnumToString = map[Type_e]string{
 		Unknown:  "Unknown",
+		Clan:     "Clan",
 		Tribe:    "Tribe",
 		Courier:  "Courier",
 		Element:  "Element",
@@ -59,6 +60,7 @@ var (
 	// StringToEnum is a helper map for unmarshalling the enum
 	StringToEnum = map[string]Type_e{
 		"Unknown":  Unknown,
+		"Clan":     Clan,
 		"Tribe":    Tribe,
 		"Courier":  Courier,
 		"Element":  Element,
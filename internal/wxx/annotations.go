@@ -0,0 +1,116 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package wxx
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strings"
+)
+
+// ottomapTag is added to the tags of the elements that ottomap draws
+// on the Worldographer layers so that they can be found on the next run.
+const ottomapTag = "ottomap"
+
+// IsOttomapLayer returns true if ottomap owns the layer. Everything on
+// these layers is replaced each time the map is created.
+func IsOttomapLayer(name string) bool {
+	return strings.HasPrefix(name, "Tribenet ")
+}
+
+// KeepAnnotations tells Create to copy the layers, features, labels, shapes,
+// and notes that the user added to an existing map into the new map.
+//
+// Anything on an ottomap layer is dropped, as are the notes attached to the
+// dropped features. Ottomap also draws on some of the Worldographer layers
+// (rivers on "Above Terrain", for example). Those elements are tagged with
+// "ottomap" and are dropped, too, even if they have moved. Maps created by
+// older versions of ottomap don't have the tag, so elements that are the same
+// as one in the new map are dropped as well.
+func (w *WXX) KeepAnnotations(doc *Document) {
+	w.annotations = doc
+}
+
+// mergeAnnotations copies the user's annotations into the XML buffer.
+// It must be called after the map has been written to the buffer.
+func (w *WXX) mergeAnnotations() error {
+	if w.annotations == nil {
+		return nil
+	}
+
+	// find the layers and elements that we just wrote
+	generated, err := parse(w.buffer.String())
+	if err != nil {
+		return fmt.Errorf("wxx: annotations: %w", err)
+	}
+	haveLayer, haveElement := map[string]bool{}, map[string]bool{}
+	for _, layer := range generated.Layers {
+		haveLayer[layer.Name] = true
+	}
+	for _, e := range generated.Elements {
+		haveElement[e.key()] = true
+	}
+
+	var layers []string
+	for _, layer := range w.annotations.Layers {
+		if !IsOttomapLayer(layer.Name) && !haveLayer[layer.Name] {
+			layers = append(layers, layer.Raw)
+		}
+	}
+
+	// features are checked first so that we know which notes to drop
+	dropped := map[string]bool{}
+	elements := map[string][]string{}
+	for _, e := range w.annotations.Elements {
+		if e.Kind == "note" {
+			continue
+		} else if IsOttomapLayer(e.MapLayer) || e.isOttomap() || haveElement[e.key()] {
+			if e.UUID != "" {
+				dropped[e.UUID] = true
+			}
+			continue
+		}
+		elements[e.Kind] = append(elements[e.Kind], e.Raw)
+	}
+	for _, e := range w.annotations.Elements {
+		if e.Kind == "note" && !dropped[e.Parent] {
+			elements[e.Kind] = append(elements[e.Kind], e.Raw)
+		}
+	}
+	log.Printf("wxx: annotations: kept %d layers, %d features, %d labels, %d shapes, %d notes\n",
+		len(layers), len(elements["feature"]), len(elements["label"]), len(elements["shape"]), len(elements["note"]))
+
+	// user layers are put above the ottomap layers, just like the trails
+	doc := w.buffer.Bytes()
+	for _, insert := range []struct {
+		before string
+		raw    []string
+	}{
+		{`<maplayer name="Labels"`, layers},
+		{`</features>`, elements["feature"]},
+		{`</labels>`, elements["label"]},
+		{`</shapes>`, elements["shape"]},
+		{`</notes>`, elements["note"]},
+	} {
+		if len(insert.raw) == 0 {
+			continue
+		} else if !bytes.Contains(doc, []byte(insert.before)) {
+			return fmt.Errorf("wxx: annotations: missing %q", insert.before)
+		}
+		doc = bytes.Replace(doc, []byte(insert.before), []byte(strings.Join(insert.raw, "\n")+"\n"+insert.before), 1)
+	}
+	w.buffer = bytes.NewBuffer(doc)
+
+	return nil
+}
+
+// isOttomap returns true if ottomap tagged the element.
+func (e *Element) isOttomap() bool {
+	for _, tag := range strings.Split(e.Tags, ",") {
+		if strings.TrimSpace(tag) == ottomapTag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package wxx_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/direction"
+	"github.com/mdhender/ottomap/internal/terrain"
+	"github.com/mdhender/ottomap/internal/wxx"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+func TestKeepAnnotations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "0991.wxx")
+
+	// the first map, with a river and a label drawn by ottomap
+	if err := render(path, 0, nil); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	first, err := wxx.Read(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	wantShapes, wantLabels := count(first, "shape", "Above Terrain", ""), count(first, "label", "Labels", "Home")
+	if wantShapes == 0 || wantLabels != 1 {
+		t.Fatalf("first: want river shapes and 1 label, got %d shapes, %d labels", wantShapes, wantLabels)
+	}
+
+	// the user adds a layer and a label on it
+	doc := readXML(t, path)
+	doc = strings.Replace(doc, `<maplayer name="Labels"`, `<maplayer name="My Notes" isVisible="true"/>`+"\n"+`<maplayer name="Labels"`, 1)
+	doc = strings.Replace(doc, `</labels>`, `<label  mapLayer="My Notes" style="null" fontFace="null" color="0.0,0.0,0.0,1.0" outlineColor="1.0,1.0,1.0,1.0" outlineSize="0.0" rotate="0.0" isBold="false" isItalic="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isGMOnly="false" tags=""><location viewLevel="WORLD" x="10.0" y="20.0" scale="12.5" />Cave</label>`+"\n"+`</labels>`, 1)
+	if err := os.WriteFile(path, []byte(doc), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	// the map is created twice more, the second time with the tiles shifted
+	// so that ottomap's elements on the shared layers move
+	for _, shift := range []int{0, 1} {
+		annotations, err := wxx.Read(path)
+		if err != nil {
+			t.Fatalf("shift %d: read: %v", shift, err)
+		}
+		if err := render(path, shift, annotations); err != nil {
+			t.Fatalf("shift %d: render: %v", shift, err)
+		}
+		got, err := wxx.Read(path)
+		if err != nil {
+			t.Fatalf("shift %d: read: %v", shift, err)
+		}
+		if n := count(got, "label", "My Notes", "Cave"); n != 1 {
+			t.Errorf("shift %d: user label: want 1, got %d", shift, n)
+		}
+		var haveLayer bool
+		for _, layer := range got.Layers {
+			haveLayer = haveLayer || layer.Name == "My Notes"
+		}
+		if !haveLayer {
+			t.Errorf("shift %d: user layer: want kept, got dropped", shift)
+		}
+		if n := count(got, "shape", "Above Terrain", ""); n != wantShapes {
+			t.Errorf("shift %d: river shapes: want %d, got %d", shift, wantShapes, n)
+		}
+		if n := count(got, "label", "Labels", "Home"); n != wantLabels {
+			t.Errorf("shift %d: ottomap label: want %d, got %d", shift, wantLabels, n)
+		}
+	}
+}
+
+// render creates a small map with the tiles shifted right by shift columns.
+func render(path string, shift int, annotations *wxx.Document) error {
+	w := wxx.NewWXX()
+	if annotations != nil {
+		w.KeepAnnotations(annotations)
+	}
+	for column := 1; column <= 3; column++ {
+		for row := 1; row <= 3; row++ {
+			location := coords.Map{Column: column, Row: row}
+			hex := &wxx.Hex{
+				Location:   location,
+				RenderAt:   coords.Map{Column: column + shift, Row: row},
+				Terrain:    terrain.Prairie,
+				WasVisited: true,
+				LastSeen:   "0900-01",
+			}
+			if column == 2 && row == 2 {
+				hex.Features.Edges.River = []direction.Direction_e{direction.North, direction.SouthEast}
+				hex.Features.Label = &wxx.Label{Text: "Home"}
+			}
+			if err := w.MergeHex(hex); err != nil {
+				return err
+			}
+		}
+	}
+	return w.Create(path, "0900-01", coords.Map{Column: 1, Row: 1}, coords.Map{Column: 3 + shift, Row: 3}, wxx.RenderConfig{})
+}
+
+// count returns the number of elements of the kind on the layer.
+// If text is not empty, only labels with that text are counted.
+func count(doc *wxx.Document, kind, layer, text string) (n int) {
+	for _, e := range doc.Elements {
+		if e.Kind == kind && e.MapLayer == layer && (text == "" || e.Text == text) {
+			n++
+		}
+	}
+	return n
+}
+
+// readXML returns the XML from a map file that ottomap created.
+func readXML(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("gzip: %v", err)
+	}
+	data, err = io.ReadAll(gz)
+	if err != nil {
+		t.Fatalf("gzip: %v", err)
+	}
+	// ottomap writes big-endian UTF-16 with a BOM
+	var buf16 []uint16
+	for n := 2; n+1 < len(data); n += 2 {
+		buf16 = append(buf16, binary.BigEndian.Uint16(data[n:]))
+	}
+	return string(utf16.Decode(buf16))
+}
@@ -73,6 +73,7 @@ func (w *WXX) MergeHex(hex *Hex) error {
 
 	t.WasScouted = t.WasScouted || hex.WasScouted
 	t.WasVisited = t.WasVisited || hex.WasVisited
+	t.LastSeen = max(t.LastSeen, hex.LastSeen)
 	t.Features = hex.Features
 
 	return nil
@@ -4,6 +4,7 @@ package wxx
 
 import (
 	"fmt"
+	"github.com/mdhender/ottomap/internal/coords"
 	"github.com/mdhender/ottomap/internal/direction"
 	"math"
 )
@@ -69,6 +70,48 @@ func coordsToPoints(column, row int) [7]Point {
 	return points
 }
 
+// HexPoints returns the center point and vertices of the hexagon rendered at
+// the given column and row. It lets the other renderers draw the same hexes
+// as the Worldographer map.
+func HexPoints(column, row int) [7]Point {
+	return coordsToPoints(column, row)
+}
+
+// Bounds returns the top left and bottom right corners of the hexes, with a
+// margin so that the edges and labels of the outer hexes aren't cut off. It
+// also returns the offset from the true location of a hex to the location
+// it is rendered at, which TrailPoints needs. There must be at least one hex.
+func Bounds(hexes []*Hex) (topLeft, bottomRight Point, renderOffset coords.Map) {
+	const margin = 150
+	minX, minY, maxX, maxY := math.MaxFloat64, math.MaxFloat64, -math.MaxFloat64, -math.MaxFloat64
+	for _, hex := range hexes {
+		for _, p := range coordsToPoints(hex.RenderAt.Column, hex.RenderAt.Row) {
+			minX, minY = math.Min(minX, p.X), math.Min(minY, p.Y)
+			maxX, maxY = math.Max(maxX, p.X), math.Max(maxY, p.Y)
+		}
+	}
+	renderOffset = coords.Map{
+		Column: hexes[0].Location.Column - hexes[0].RenderAt.Column,
+		Row:    hexes[0].Location.Row - hexes[0].RenderAt.Row,
+	}
+	return Point{X: minX - margin, Y: minY - margin}, Point{X: maxX + margin, Y: maxY + margin}, renderOffset
+}
+
+// EdgeCenter returns the center of the edge of the hexagon.
+func EdgeCenter(edge direction.Direction_e, v [7]Point) Point {
+	return edgeCenter(edge, v)
+}
+
+// EdgeVertices returns the two vertices of the edge of the hexagon.
+func EdgeVertices(edge direction.Direction_e, v [7]Point) (from, to Point) {
+	return edgeVertices(edge, v)
+}
+
+// Midpoint returns the point halfway between the two points.
+func Midpoint(p1, p2 Point) Point {
+	return midpoint(p1, p2)
+}
+
 func bottomLeftCenter(v [7]Point) Point {
 	bc := edgeCenter(direction.South, v)
 	return Point{X: (v[6].X + bc.X) / 2, Y: bc.Y}
@@ -106,6 +149,25 @@ func edgeCenter(edge direction.Direction_e, v [7]Point) Point {
 	return midpoint(v[from], v[to])
 }
 
+// edgeVertices returns the two vertices of the edge.
+func edgeVertices(edge direction.Direction_e, v [7]Point) (from, to Point) {
+	switch edge {
+	case direction.North:
+		return v[2], v[3]
+	case direction.NorthEast:
+		return v[3], v[4]
+	case direction.SouthEast:
+		return v[4], v[5]
+	case direction.South:
+		return v[5], v[6]
+	case direction.SouthWest:
+		return v[6], v[1]
+	case direction.NorthWest:
+		return v[1], v[2]
+	}
+	panic(fmt.Sprintf("assert(direction != %d)", edge))
+}
+
 func midpoint(p1, p2 Point) Point {
 	return Point{
 		X: (p1.X + p2.X) / 2,
@@ -0,0 +1,201 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package wxx
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf16"
+)
+
+// Document is the layers and annotations read from a Worldographer map file.
+// The tiles aren't kept since ottomap always creates them from the reports.
+type Document struct {
+	Layers   []*Layer
+	Elements []*Element
+}
+
+// Layer is a map layer from the file.
+type Layer struct {
+	Name string
+	Raw  string // XML of the layer as it was read
+}
+
+// Element is a feature, label, shape, or note from the file.
+type Element struct {
+	Kind     string  // "feature", "label", "shape", or "note"
+	MapLayer string  // empty for notes
+	UUID     string  // features only
+	Parent   string  // notes only; the uuid of the feature the note is attached to
+	Type     string  // type of the feature or shape
+	Tags     string  // comma separated list of tags
+	Text     string  // text of the label
+	Location Point   // features and labels
+	Points   []Point // shapes
+	Raw      string  // XML of the element as it was read
+}
+
+// Read decodes the map file at path.
+func Read(path string) (*Document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Decode(data)
+}
+
+// Decode decodes a map file. Worldographer writes gzip'd UTF-16, but plain
+// files and UTF-8 are accepted so that hand edited maps can be read.
+func Decode(data []byte) (*Document, error) {
+	if len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b {
+		gz, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("wxx: decode: %w", err)
+		}
+		data, err = io.ReadAll(gz)
+		if err != nil {
+			return nil, fmt.Errorf("wxx: decode: %w", err)
+		}
+	}
+
+	// convert UTF-16 to UTF-8, using the BOM to find the byte order
+	if len(data) >= 2 && ((data[0] == 0xfe && data[1] == 0xff) || (data[0] == 0xff && data[1] == 0xfe)) {
+		var order binary.ByteOrder = binary.BigEndian
+		if data[0] == 0xff {
+			order = binary.LittleEndian
+		}
+		data = data[2:]
+		if len(data)%2 != 0 {
+			return nil, fmt.Errorf("wxx: decode: odd number of bytes in utf-16 data")
+		}
+		buf16 := make([]uint16, 0, len(data)/2)
+		for n := 0; n < len(data); n += 2 {
+			buf16 = append(buf16, order.Uint16(data[n:]))
+		}
+		data = []byte(string(utf16.Decode(buf16)))
+	}
+
+	return parse(string(data))
+}
+
+// parse reads the layers and annotations from the XML.
+func parse(doc string) (*Document, error) {
+	d := newDecoder(strings.NewReader(doc))
+	document := &Document{}
+	for {
+		// the offset before a start element is the '<' that starts it
+		start := d.InputOffset()
+		token, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("wxx: parse: %w", err)
+		}
+		se, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch se.Name.Local {
+		case "maplayer", "feature", "label", "shape", "note":
+		default:
+			continue
+		}
+		if err := d.Skip(); err != nil {
+			return nil, fmt.Errorf("wxx: parse: %s: %w", se.Name.Local, err)
+		}
+		raw := doc[start:d.InputOffset()]
+		if se.Name.Local == "maplayer" {
+			layer := &Layer{Raw: raw}
+			for _, attr := range se.Attr {
+				if attr.Name.Local == "name" {
+					layer.Name = attr.Value
+				}
+			}
+			document.Layers = append(document.Layers, layer)
+			continue
+		}
+		element, err := parseElement(raw)
+		if err != nil {
+			return nil, fmt.Errorf("wxx: parse: %s: %w", se.Name.Local, err)
+		}
+		document.Elements = append(document.Elements, element)
+	}
+	return document, nil
+}
+
+// parseElement extracts the fields we need to compare elements.
+func parseElement(raw string) (*Element, error) {
+	type point struct {
+		X float64 `xml:"x,attr"`
+		Y float64 `xml:"y,attr"`
+	}
+	var v struct {
+		XMLName  xml.Name
+		MapLayer string  `xml:"mapLayer,attr"`
+		UUID     string  `xml:"uuid,attr"`
+		Parent   string  `xml:"parent,attr"`
+		Type     string  `xml:"type,attr"`
+		Tags     string  `xml:"tags,attr"`
+		Location point   `xml:"location"`
+		Points   []point `xml:"p"`
+		Text     string  `xml:",chardata"`
+	}
+	if err := newDecoder(strings.NewReader(raw)).Decode(&v); err != nil {
+		return nil, err
+	}
+	e := &Element{
+		Kind:     v.XMLName.Local,
+		MapLayer: v.MapLayer,
+		UUID:     v.UUID,
+		Parent:   v.Parent,
+		Type:     v.Type,
+		Tags:     v.Tags,
+		Location: Point{X: v.Location.X, Y: v.Location.Y},
+	}
+	if e.Kind == "label" {
+		e.Text = strings.TrimSpace(v.Text)
+	}
+	for _, p := range v.Points {
+		e.Points = append(e.Points, Point{X: p.X, Y: p.Y})
+	}
+	e.Raw = raw
+	return e, nil
+}
+
+// newDecoder returns a decoder that accepts the maps that ottomap and
+// Worldographer write. The input has already been converted to UTF-8,
+// and older versions of ottomap didn't escape the text in labels.
+func newDecoder(r io.Reader) *xml.Decoder {
+	d := xml.NewDecoder(r)
+	d.Strict = false
+	d.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	return d
+}
+
+// key returns a string that is the same for elements that draw the same
+// thing in the same place, even if Worldographer has rewritten the file.
+func (e *Element) key() string {
+	switch e.Kind {
+	case "feature":
+		return fmt.Sprintf("feature|%s|%s|%.1f|%.1f", e.MapLayer, e.Type, e.Location.X, e.Location.Y)
+	case "label":
+		return fmt.Sprintf("label|%s|%s|%.1f|%.1f", e.MapLayer, e.Text, e.Location.X, e.Location.Y)
+	case "shape":
+		var sb strings.Builder
+		_, _ = fmt.Fprintf(&sb, "shape|%s|%s", e.MapLayer, e.Type)
+		for _, p := range e.Points {
+			_, _ = fmt.Fprintf(&sb, "|%.1f,%.1f", p.X, p.Y)
+		}
+		return sb.String()
+	}
+	return e.Kind + "|" + e.Raw
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package wxx
+
+import (
+	"github.com/mdhender/ottomap/internal/turns"
+)
+
+// turnsBetween returns the number of turns from one turn id to a later one.
+// There is one turn per month. It returns -1 if either id is not valid.
+func turnsBetween(from, to string) int {
+	fromYear, fromMonth, err := turns.ParseTurnId(from)
+	if err != nil {
+		return -1
+	}
+	toYear, toMonth, err := turns.ParseTurnId(to)
+	if err != nil {
+		return -1
+	}
+	return (toYear*12 + toMonth) - (fromYear*12 + fromMonth)
+}
+
+// stalenessColor returns the label color for information that is age turns old.
+// Information from the current turn is green, then yellow for 1 to 3 turns,
+// orange for 4 to 12 turns, and red for anything older.
+func stalenessColor(age int) string {
+	switch {
+	case age < 0:
+		// invalid turn ids, so we don't know how old it is
+		return "0.5,0.5,0.5,1.0"
+	case age == 0:
+		return "0.0,0.6000000238418579,0.0,1.0"
+	case age <= 3:
+		return "0.800000011920929,0.800000011920929,0.0,1.0"
+	case age <= 12:
+		return "1.0,0.6000000238418579,0.0,1.0"
+	}
+	return "1.0,0.0,0.0,1.0"
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package wxx
+
+import (
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/terrain"
+	"path/filepath"
+	"testing"
+)
+
+// the staleness helpers aren't exported, so this test is in package wxx.
+
+func TestTurnsBetween(t *testing.T) {
+	for _, tc := range []struct {
+		id       string
+		from, to string
+		want     int
+	}{
+		{id: "same turn", from: "0901-05", to: "0901-05", want: 0},
+		{id: "next turn", from: "0901-05", to: "0901-06", want: 1},
+		{id: "year rollover", from: "0900-12", to: "0901-01", want: 1},
+		{id: "across years", from: "0899-12", to: "0901-02", want: 14},
+		{id: "later from", from: "0901-02", to: "0901-01", want: -1},
+		{id: "never visited", from: "", to: "0901-01", want: -1},
+		{id: "invalid to", from: "0901-01", to: "N/A", want: -1},
+		{id: "invalid from month", from: "0901-13", to: "0902-01", want: -1},
+		{id: "invalid to month", from: "0901-01", to: "0901-00", want: -1},
+	} {
+		if got := turnsBetween(tc.from, tc.to); got != tc.want {
+			t.Errorf("%s: %q to %q: want %d, got %d", tc.id, tc.from, tc.to, tc.want, got)
+		}
+	}
+}
+
+func TestStalenessColor(t *testing.T) {
+	const green, yellow, orange, red, grey = "0.0,0.6000000238418579,0.0,1.0", "0.800000011920929,0.800000011920929,0.0,1.0", "1.0,0.6000000238418579,0.0,1.0", "1.0,0.0,0.0,1.0", "0.5,0.5,0.5,1.0"
+	for _, tc := range []struct {
+		age  int
+		want string
+	}{
+		{age: -1, want: grey},
+		{age: 0, want: green},
+		{age: 1, want: yellow},
+		{age: 3, want: yellow},
+		{age: 4, want: orange},
+		{age: 12, want: orange},
+		{age: 13, want: red},
+		{age: 120, want: red},
+	} {
+		if got := stalenessColor(tc.age); got != tc.want {
+			t.Errorf("age %d: want %q, got %q", tc.age, tc.want, got)
+		}
+	}
+	// a hex that was never visited has no turn id, so its age is unknown
+	if got := stalenessColor(turnsBetween("", "0901-01")); got != grey {
+		t.Errorf("never visited: want %q, got %q", grey, got)
+	}
+}
+
+func TestStalenessLabels(t *testing.T) {
+	w := NewWXX()
+	for _, hex := range []*Hex{
+		{Location: coords.Map{Column: 1, Row: 1}, RenderAt: coords.Map{Column: 1, Row: 1}, Terrain: terrain.Prairie, WasVisited: true, LastSeen: "0900-12"},
+		{Location: coords.Map{Column: 2, Row: 1}, RenderAt: coords.Map{Column: 2, Row: 1}, Terrain: terrain.Prairie, WasScouted: true, LastSeen: "0901-01"},
+		// never visited or scouted, only seen from a neighboring hex
+		{Location: coords.Map{Column: 3, Row: 1}, RenderAt: coords.Map{Column: 3, Row: 1}, Terrain: terrain.Prairie},
+	} {
+		if err := w.MergeHex(hex); err != nil {
+			t.Fatalf("merge: %v", err)
+		}
+	}
+	var cfg RenderConfig
+	cfg.Show.Staleness = true
+	path := filepath.Join(t.TempDir(), "0991.wxx")
+	if err := w.Create(path, "0901-01", coords.Map{Column: 1, Row: 1}, coords.Map{Column: 3, Row: 1}, cfg); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	doc, err := Read(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+
+	got := map[string]int{}
+	for _, e := range doc.Elements {
+		if e.Kind == "label" && e.MapLayer == "Tribenet Staleness" {
+			got[e.Text]++
+		}
+	}
+	if len(got) != 2 || got["0900-12"] != 1 || got["0901-01"] != 1 {
+		t.Errorf("labels: want one each for 0900-12 and 0901-01, got %v", got)
+	}
+}
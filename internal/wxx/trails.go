@@ -0,0 +1,131 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package wxx
+
+import (
+	"fmt"
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/units"
+)
+
+// Trail is the path a unit took across one or more turns.
+type Trail struct {
+	Layer  string       // map layer to draw the trail on
+	UnitId string       // unit that made the trail
+	Type   units.Type_e // type of the unit, used to pick the color
+	Scout  bool         // true if the trail is from a scouting party
+	Steps  []*TrailStep
+}
+
+// TrailStep is a hex the unit was in. TurnId is set only on the last
+// step of a turn so that the end of each turn can be labeled.
+type TrailStep struct {
+	Location coords.Map
+	TurnId   string
+}
+
+// AddTrail adds a unit's trail to the map.
+// Trails with fewer than two steps are ignored since there is no line to draw.
+func (w *WXX) AddTrail(trail *Trail) {
+	if len(trail.Steps) < 2 {
+		return
+	}
+	w.trails = append(w.trails, trail)
+}
+
+// trailLayers returns the map layers used by the trails, in the order they were added.
+func (w *WXX) trailLayers() (layers []string) {
+	seen := map[string]bool{}
+	for _, trail := range w.trails {
+		if !seen[trail.Layer] {
+			seen[trail.Layer] = true
+			layers = append(layers, trail.Layer)
+		}
+	}
+	return layers
+}
+
+// RGB returns the color of the trail. Scouting parties are green and
+// units are colored by their type.
+func (t *Trail) RGB() (r, g, b float64) {
+	if t.Scout {
+		return 0.0, 0.6, 0.0
+	}
+	switch t.Type {
+	case units.Clan, units.Tribe:
+		return 1.0, 0.0, 0.0
+	case units.Courier:
+		return 1.0, 0.6, 0.0
+	case units.Element:
+		return 0.6, 0.0, 0.8
+	case units.Fleet:
+		return 0.0, 0.0, 1.0
+	case units.Garrison:
+		return 0.4, 0.4, 0.4
+	}
+	return 0.0, 0.0, 0.0
+}
+
+// trailColor returns the stroke color for the trail.
+func trailColor(trail *Trail) string {
+	r, g, b := trail.RGB()
+	return fmt.Sprintf("%g,%g,%g,1.0", r, g, b)
+}
+
+// TrailPoints returns the center of each hex on the trail, skipping the steps
+// that didn't leave the hex. renderOffset shifts the true location to the
+// location the tile is rendered at.
+func TrailPoints(trail *Trail, renderOffset coords.Map) (points []Point) {
+	var last coords.Map
+	for n, step := range trail.Steps {
+		if n != 0 && step.Location == last {
+			continue
+		}
+		last = step.Location
+		at := coordsToPoints(step.Location.Column-renderOffset.Column, step.Location.Row-renderOffset.Row)
+		points = append(points, at[0])
+	}
+	return points
+}
+
+// writeTrailShapes draws each trail as a path through the centers of the hexes.
+func (w *WXX) writeTrailShapes(renderOffset coords.Map) {
+	for _, trail := range w.trails {
+		points := TrailPoints(trail, renderOffset)
+		if len(points) < 2 {
+			continue
+		}
+		w.Printf(`<shape  type="Path" isCurve="false" isGMOnly="false" isSnapVertices="true" isMatchTileBorders="false" tags="" creationType="BASIC" isDropShadow="false" isInnerShadow="false" isBoxBlur="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" dsSpread="0.2" dsRadius="50.0" dsOffsetX="0.0" dsOffsetY="0.0" insChoke="0.2" insRadius="50.0" insOffsetX="0.0" insOffsetY="0.0" bbWidth="10.0" bbHeight="10.0" bbIterations="3" mapLayer=%q fillTexture="" strokeTexture="" strokeType="SIMPLE" highestViewLevel="WORLD" currentShapeViewLevel="WORLD" lineCap="ROUND" lineJoin="ROUND" opacity="0.75" fillRule="NON_ZERO" strokeColor="%s" strokeWidth="0.03" dsColor="1.0,0.8941176533699036,0.7686274647712708,1.0" insColor="1.0,0.8941176533699036,0.7686274647712708,1.0">`, trail.Layer, trailColor(trail))
+		for n, point := range points {
+			if n == 0 {
+				w.Printf(` <p type="m" x="%f" y="%f"/>`, point.X, point.Y)
+			} else {
+				w.Printf(` <p x="%f" y="%f"/>`, point.X, point.Y)
+			}
+		}
+		w.Println(`</shape>`)
+	}
+}
+
+// writeTrailLabels labels the hex the unit ended each turn in with the turn id.
+// If the unit ended more than one turn in the same hex, only the first turn is labeled.
+func (w *WXX) writeTrailLabels(renderOffset coords.Map) {
+	for _, trail := range w.trails {
+		if trail.Scout {
+			continue
+		}
+		var last coords.Map
+		for _, step := range trail.Steps {
+			if step.TurnId == "" || step.Location == last {
+				continue
+			}
+			last = step.Location
+			points := coordsToPoints(step.Location.Column-renderOffset.Column, step.Location.Row-renderOffset.Row)
+			labelXY := midpoint(points[0], points[1])
+			w.Printf(`<label  mapLayer=%q style="null" fontFace="null" color="%s" outlineColor="1.0,1.0,1.0,1.0" outlineSize="0.0" rotate="0.0" isBold="false" isItalic="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isGMOnly="false" tags="">`, trail.Layer, trailColor(trail))
+			w.Printf(`<location viewLevel="WORLD" x="%g" y="%g" scale="3.125" />`, labelXY.X, labelXY.Y)
+			w.Printf("%s %s", trail.UnitId, step.TurnId)
+			w.Printf("</label>\n")
+		}
+	}
+}
@@ -17,6 +17,7 @@ type Hex struct {
 	Terrain    terrain.Terrain_e
 	WasScouted bool
 	WasVisited bool
+	LastSeen   string // turn id when the hex was last visited or scouted
 	Features   Features
 }
 
@@ -37,6 +38,7 @@ type Tile struct {
 	Resources  Resources
 	WasScouted bool
 	WasVisited bool
+	LastSeen   string // turn id when the tile was last visited or scouted
 	Features   Features
 }
 
@@ -78,8 +80,14 @@ type Features struct {
 	IsOrigin    bool // true for the clan's origin hex
 	Label       *Label
 	Encounters  []*parser.Encounter_t // other units in this tile
+	Items       []*parser.FoundItem_t // items found in this tile
+	Longhouses  []*parser.Longhouse_t // longhouses in this tile
+	Obstacles   []*parser.Obstacle_t  // borders that stopped units from leaving this tile
+	Patrols     []*parser.Patrol_t    // outcomes of scouting parties in this tile
 	Resources   []resources.Resource_e
 	Settlements []*parser.Settlement_t // name of settlement
+	Sightings   []*parser.Sighting_t   // crow's-nest sightings of this tile
+	Winds       []*parser.Wind_t       // winds reported by fleets in this tile
 }
 
 type Resources struct {
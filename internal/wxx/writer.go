@@ -10,22 +10,28 @@ import (
 	"github.com/google/uuid"
 	"github.com/mdhender/ottomap/internal/coords"
 	"github.com/mdhender/ottomap/internal/direction"
+	"github.com/mdhender/ottomap/internal/parser"
 	"github.com/mdhender/ottomap/internal/resources"
+	"github.com/mdhender/ottomap/internal/results"
 	"github.com/mdhender/ottomap/internal/terrain"
 	"log"
 	"os"
+	"slices"
 	"strings"
 	"unicode/utf16"
 	"unicode/utf8"
 )
 
 type RenderConfig struct {
-	Show struct {
+	PixelsPerHex    int  // width of a hex on raster maps
+	KeepAnnotations bool // keep the user's annotations from the existing map file
+	Show            struct {
 		Grid struct {
 			Centers bool
 			Coords  bool
 			Numbers bool
 		}
+		Staleness bool // label each hex with the turn it was last visited or scouted
 	}
 }
 
@@ -108,6 +114,13 @@ func (w *WXX) Create(path string, turnId string, upperLeft, lowerRight coords.Ma
 	// bump the tiles wide and high by 4 so that we can render the borders
 	tilesWide, tilesHigh = tilesWide+4, tilesHigh+4
 
+	// the trails are recorded with the true location, so we need the offset to the render location
+	var renderOffset coords.Map
+	for _, t := range w.tiles {
+		renderOffset = coords.Map{Column: t.Location.Column - t.RenderAt.Column, Row: t.Location.Row - t.RenderAt.Row}
+		break
+	}
+
 	// create a two-dimensional slice of tiles so that we can render them in the order we want.
 	// the slice will be indexed by the render location row and column.
 	var allTiles [][]*Tile
@@ -158,12 +171,23 @@ func (w *WXX) Create(path string, turnId string, upperLeft, lowerRight coords.Ma
 
 	// order of these is important; worldographer renders them from the bottom up.
 	w.Println(`<maplayer name="Tribenet Resources" isVisible="true"/>`)
+	w.Println(`<maplayer name="Tribenet Items" isVisible="true"/>`)
+	w.Println(`<maplayer name="Tribenet Longhouses" isVisible="true"/>`)
+	w.Println(`<maplayer name="Tribenet Patrols" isVisible="true"/>`)
+	w.Println(`<maplayer name="Tribenet Sailing" isVisible="true"/>`)
+	w.Println(`<maplayer name="Tribenet Obstacles" isVisible="true"/>`)
 	w.Println(`<maplayer name="Tribenet Settlements" isVisible="true"/>`)
 	w.Println(`<maplayer name="Tribenet Clan Units" isVisible="true"/>`)
 	w.Println(`<maplayer name="Tribenet Encounters" isVisible="true"/>`)
 	w.Println(`<maplayer name="Tribenet Visited" isVisible="true"/>`)
+	if cfg.Show.Staleness {
+		w.Println(`<maplayer name="Tribenet Staleness" isVisible="true"/>`)
+	}
 	w.Println(`<maplayer name="Tribenet Coords" isVisible="true"/>`)
 	w.Println(`<maplayer name="Tribenet Origin" isVisible="true"/>`)
+	for _, layer := range w.trailLayers() {
+		w.Println(`<maplayer name=%q isVisible="true"/>`, layer)
+	}
 	w.Println(`<maplayer name="Labels" isVisible="true"/>`)
 	w.Println(`<maplayer name="Grid" isVisible="true"/>`)
 	w.Println(`<maplayer name="Features" isVisible="true"/>`)
@@ -235,9 +259,9 @@ func (w *WXX) Create(path string, turnId string, upperLeft, lowerRight coords.Ma
 
 			if t.Terrain == terrain.PrairiePlateau {
 				origin := points[0]
-				w.Printf(`<feature type="Semi-Real Hill Jagged" rotate="0.0" uuid="%s" mapLayer="Features" isFlipHorizontal="false" isFlipVertical="false" scale="90.0" scaleHt="-1.0" tags="" color="0.800000011920929,0.800000011920929,0.800000011920929,1.0" ringcolor="null" isGMOnly="false" isPlaceFreely="false" labelPosition="6:00" labelDistance="0" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isFillHexBottom="false" isHideTerrainIcon="false">`, uuid.New().String())
+				w.Printf(`<feature type="Semi-Real Hill Jagged" rotate="0.0" uuid="%s" mapLayer="Features" isFlipHorizontal="false" isFlipVertical="false" scale="90.0" scaleHt="-1.0" tags="ottomap" color="0.800000011920929,0.800000011920929,0.800000011920929,1.0" ringcolor="null" isGMOnly="false" isPlaceFreely="false" labelPosition="6:00" labelDistance="0" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isFillHexBottom="false" isHideTerrainIcon="false">`, uuid.New().String())
 				w.Printf(`<location viewLevel="WORLD" x="%f" y="%f" />`, origin.X, origin.Y)
-				w.Printf(`<label  mapLayer="Features" style="null" fontFace="null" color="0.0,0.0,0.0,1.0" outlineColor="1.0,1.0,1.0,1.0" outlineSize="0.0" rotate="0.0" isBold="false" isItalic="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isGMOnly="false" tags="">`)
+				w.Printf(`<label  mapLayer="Features" style="null" fontFace="null" color="0.0,0.0,0.0,1.0" outlineColor="1.0,1.0,1.0,1.0" outlineSize="0.0" rotate="0.0" isBold="false" isItalic="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isGMOnly="false" tags="ottomap">`)
 				w.Printf(`<location viewLevel="WORLD" x="%f" y="%f" scale="25.0" />`, origin.X, origin.Y)
 				w.Printf(`</label>`)
 				w.Printf("</feature>\n")
@@ -334,6 +358,160 @@ func (w *WXX) Create(path string, turnId string, upperLeft, lowerRight coords.Ma
 				}
 			}
 
+			if len(t.Features.Items) != 0 {
+				// label with the items from the latest turn, shifted to the south-west to avoid the resource label
+				var latest []*parser.FoundItem_t
+				var text []string
+				for _, item := range t.Features.Items {
+					if len(latest) == 0 || latest[0].TurnId < item.TurnId {
+						latest = []*parser.FoundItem_t{item}
+					} else if latest[0].TurnId == item.TurnId {
+						latest = append(latest, item)
+					}
+					text = append(text, fmt.Sprintf("%s: %d %s", item.TurnId, item.Quantity, item.Item))
+				}
+				name := fmt.Sprintf("%d %s", latest[0].Quantity, latest[0].Item)
+				if len(latest) > 1 {
+					name = "ITEMS"
+				}
+				id := uuid.New().String()
+				origin := midpoint(points[0], edgeCenter(direction.SouthWest, points))
+				w.Printf(`<feature type="Three Dots" rotate="0.0" uuid="%s" mapLayer="Tribenet Items" isFlipHorizontal="false" isFlipVertical="false" scale="25.0" scaleHt="-1.0" tags="" color="null" ringcolor="null" isGMOnly="false" isPlaceFreely="false" labelPosition="6:00" labelDistance="0" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isFillHexBottom="false" isHideTerrainIcon="false">`, id)
+				w.Printf(`<location viewLevel="WORLD" x="%f" y="%f" />`, origin.X, origin.Y)
+				w.Printf(`<label  mapLayer="Tribenet Items" style="null" fontFace="null" color="0.0,0.0,0.0,1.0" outlineColor="1.0,1.0,1.0,1.0" outlineSize="0.0" rotate="0.0" isBold="false" isItalic="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isGMOnly="false" tags="">`)
+				w.Printf(`<location viewLevel="WORLD" x="%g" y="%g" scale="6.25" />`, origin.X, origin.Y)
+				w.Printf("%s", name)
+				w.Printf(`</label>`)
+				w.Println(`</feature>`)
+				// the note lists every item found in the tile
+				notes.Notes[id] = &FeatureNote{
+					Id:     id,
+					Title:  "Items",
+					Text:   text,
+					Origin: origin,
+				}
+			}
+
+			if len(t.Features.Patrols) != 0 {
+				// flag the hex with the outcome of the latest patrols.
+				// lost scouts mark the hex as dangerous, otherwise it is clear or units were found.
+				var latest string
+				for _, p := range t.Features.Patrols {
+					if latest < p.TurnId {
+						latest = p.TurnId
+					}
+				}
+				var lost, found bool
+				var text []string
+				for _, p := range t.Features.Patrols {
+					if p.TurnId == latest {
+						lost, found = lost || p.Lost, found || len(p.Found) != 0
+					}
+					text = append(text, p.String())
+				}
+				name, color := "CLEAR", "0.0,0.6000000238418579,0.0,1.0"
+				if lost {
+					name, color = "LOST", "1.0,0.0,0.0,1.0"
+				} else if found {
+					name, color = "FOUND", "1.0,0.6000000238418579,0.0,1.0"
+				}
+				id := uuid.New().String()
+				origin := midpoint(points[0], edgeCenter(direction.North, points))
+				w.Printf(`<feature type="Three Dots" rotate="0.0" uuid="%s" mapLayer="Tribenet Patrols" isFlipHorizontal="false" isFlipVertical="false" scale="25.0" scaleHt="-1.0" tags="" color=%q ringcolor="null" isGMOnly="false" isPlaceFreely="false" labelPosition="12:00" labelDistance="0" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isFillHexBottom="false" isHideTerrainIcon="false">`, id, color)
+				w.Printf(`<location viewLevel="WORLD" x="%f" y="%f" />`, origin.X, origin.Y)
+				w.Printf(`<label  mapLayer="Tribenet Patrols" style="null" fontFace="null" color=%q outlineColor="1.0,1.0,1.0,1.0" outlineSize="0.0" rotate="0.0" isBold="false" isItalic="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isGMOnly="false" tags="">`, color)
+				w.Printf(`<location viewLevel="WORLD" x="%g" y="%g" scale="6.25" />`, origin.X, origin.Y)
+				w.Printf("%s %s", name, latest)
+				w.Printf(`</label>`)
+				w.Println(`</feature>`)
+				notes.Notes[id] = &FeatureNote{
+					Id:     id,
+					Title:  "Patrols",
+					Text:   text,
+					Origin: origin,
+				}
+			}
+
+			// crow's-nest sightings are only flagged while we don't know the actual terrain.
+			if len(t.Features.Sightings) != 0 && (t.Terrain == terrain.UnknownLand || t.Terrain == terrain.UnknownWater) {
+				var latest string
+				var text []string
+				for _, s := range t.Features.Sightings {
+					if latest < s.TurnId {
+						latest = s.TurnId
+					}
+					text = append(text, s.String())
+				}
+				name := "LAND?"
+				if t.Terrain == terrain.UnknownWater {
+					name = "WATER?"
+				}
+				id := uuid.New().String()
+				origin := points[0]
+				w.Printf(`<feature type="Three Dots" rotate="0.0" uuid="%s" mapLayer="Tribenet Sailing" isFlipHorizontal="false" isFlipVertical="false" scale="25.0" scaleHt="-1.0" tags="" color="0.5,0.5,0.5,1.0" ringcolor="null" isGMOnly="false" isPlaceFreely="false" labelPosition="12:00" labelDistance="0" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isFillHexBottom="false" isHideTerrainIcon="false">`, id)
+				w.Printf(`<location viewLevel="WORLD" x="%f" y="%f" />`, origin.X, origin.Y)
+				w.Printf(`<label  mapLayer="Tribenet Sailing" style="null" fontFace="null" color="0.5,0.5,0.5,1.0" outlineColor="1.0,1.0,1.0,1.0" outlineSize="0.0" rotate="0.0" isBold="false" isItalic="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isGMOnly="false" tags="">`)
+				w.Printf(`<location viewLevel="WORLD" x="%g" y="%g" scale="6.25" />`, origin.X, origin.Y)
+				w.Printf("%s %s", name, latest)
+				w.Printf(`</label>`)
+				w.Println(`</feature>`)
+				notes.Notes[id] = &FeatureNote{
+					Id:     id,
+					Title:  "Sightings",
+					Text:   text,
+					Origin: origin,
+				}
+			}
+
+			if len(t.Features.Winds) != 0 {
+				// label with the winds from the latest turn, near the south edge of the hex
+				var latest []*parser.Wind_t
+				var text []string
+				for _, wind := range t.Features.Winds {
+					if len(latest) == 0 || latest[0].TurnId < wind.TurnId {
+						latest = []*parser.Wind_t{wind}
+					} else if latest[0].TurnId == wind.TurnId {
+						latest = append(latest, wind)
+					}
+					text = append(text, wind.String())
+				}
+				var names []string
+				for _, wind := range latest {
+					name := fmt.Sprintf("%s %s", wind.Strength, wind.From)
+					if !slices.Contains(names, name) {
+						names = append(names, name)
+					}
+				}
+				id := uuid.New().String()
+				origin := midpoint(points[0], edgeCenter(direction.South, points))
+				w.Printf(`<feature type="Three Dots" rotate="0.0" uuid="%s" mapLayer="Tribenet Sailing" isFlipHorizontal="false" isFlipVertical="false" scale="25.0" scaleHt="-1.0" tags="" color="0.0,0.0,1.0,1.0" ringcolor="null" isGMOnly="false" isPlaceFreely="false" labelPosition="6:00" labelDistance="0" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isFillHexBottom="false" isHideTerrainIcon="false">`, id)
+				w.Printf(`<location viewLevel="WORLD" x="%f" y="%f" />`, origin.X, origin.Y)
+				w.Printf(`<label  mapLayer="Tribenet Sailing" style="null" fontFace="null" color="0.0,0.0,1.0,1.0" outlineColor="1.0,1.0,1.0,1.0" outlineSize="0.0" rotate="0.0" isBold="false" isItalic="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isGMOnly="false" tags="">`)
+				w.Printf(`<location viewLevel="WORLD" x="%g" y="%g" scale="6.25" />`, origin.X, origin.Y)
+				w.Printf("%s", strings.Join(names, ", "))
+				w.Printf(`</label>`)
+				w.Println(`</feature>`)
+				notes.Notes[id] = &FeatureNote{
+					Id:     id,
+					Title:  "Winds",
+					Text:   text,
+					Origin: origin,
+				}
+			}
+
+			for n, lh := range t.Features.Longhouses {
+				// stack the longhouses down from the south-east corner of the hex
+				origin := midpoint(points[0], edgeCenter(direction.SouthEast, points))
+				origin.Y += float64(n) * 40
+				w.Printf(`<feature type="Settlement Village" rotate="0.0" uuid="%s" mapLayer="Tribenet Longhouses" isFlipHorizontal="false" isFlipVertical="false" scale="25.0" scaleHt="-1.0" tags="" color="null" ringcolor="null" isGMOnly="false" isPlaceFreely="false" labelPosition="6:00" labelDistance="0" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isFillHexBottom="false" isHideTerrainIcon="false">`, uuid.New().String())
+				w.Printf(`<location viewLevel="WORLD" x="%f" y="%f" />`, origin.X, origin.Y)
+				w.Printf(`<label  mapLayer="Tribenet Longhouses" style="null" fontFace="null" color="0.0,0.0,0.0,1.0" outlineColor="1.0,1.0,1.0,1.0" outlineSize="0.0" rotate="0.0" isBold="false" isItalic="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isGMOnly="false" tags="">`)
+				w.Printf(`<location viewLevel="WORLD" x="%g" y="%g" scale="6.25" />`, origin.X, origin.Y)
+				w.Printf("%s (%d)", lh.Id, lh.Capacity)
+				w.Printf(`</label>`)
+				w.Println(`</feature>`)
+			}
+
 			for _, s := range t.Features.Settlements {
 				if s != nil && s.Name != "" && !strings.HasPrefix(s.Name, "_") {
 					settlement := points[0]
@@ -385,6 +563,15 @@ func (w *WXX) Create(path string, turnId string, upperLeft, lowerRight coords.Ma
 					w.Printf("</label>/n")
 				}
 
+				if cfg.Show.Staleness && t.LastSeen != "" {
+					color := stalenessColor(turnsBetween(t.LastSeen, turnId))
+					labelXY := points[0].Translate(Point{X: 0, Y: -100})
+					w.Printf(`<label  mapLayer="Tribenet Staleness" style="null" fontFace="null" color="%s" outlineColor="1.0,1.0,1.0,1.0" outlineSize="0.0" rotate="0.0" isBold="false" isItalic="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isGMOnly="false" tags="">`, color)
+					w.Printf(`<location viewLevel="WORLD" x="%g" y="%g" scale="6.25" />`, labelXY.X, labelXY.Y)
+					w.Printf("%s", t.LastSeen)
+					w.Printf("</label>\n")
+				}
+
 				if t.Features.CoordsLabel != "" {
 					labelXY := bottomLeftCenter(points).Translate(Point{-9, -2.5})
 					w.Printf(`<label  mapLayer="Tribenet Coords" style="null" fontFace="null" color="0.0,0.0,0.0,1.0" outlineColor="1.0,1.0,1.0,1.0" outlineSize="0.0" rotate="0.0" isBold="false" isItalic="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isGMOnly="false" tags="">`)
@@ -402,7 +589,7 @@ func (w *WXX) Create(path string, turnId string, upperLeft, lowerRight coords.Ma
 
 			if t.Features.Label != nil {
 				labelXY := points[0]
-				w.Printf(`<label  mapLayer="Labels" style="null" fontFace="null" color="0.0,0.0,0.0,1.0" outlineColor="1.0,1.0,1.0,1.0" outlineSize="0.0" rotate="0.0" isBold="false" isItalic="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isGMOnly="false" tags="">`)
+				w.Printf(`<label  mapLayer="Labels" style="null" fontFace="null" color="0.0,0.0,0.0,1.0" outlineColor="1.0,1.0,1.0,1.0" outlineSize="0.0" rotate="0.0" isBold="false" isItalic="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isGMOnly="false" tags="ottomap">`)
 				w.Printf(`<location viewLevel="WORLD" x="%g" y="%g" scale="12.5" />`, labelXY.X, labelXY.Y)
 				w.Printf("%s", t.Features.Label.Text)
 				w.Printf("</label>\n")
@@ -418,9 +605,20 @@ func (w *WXX) Create(path string, turnId string, upperLeft, lowerRight coords.Ma
 					w.Printf("</label>\n")
 				}
 			}
+
+			// label the obstacles with the types of units that were stopped
+			for _, obstacle := range obstaclesByDirection(t.Features.Obstacles) {
+				labelXY := midpoint(points[0], edgeCenter(obstacle.direction, points))
+				w.Printf(`<label  mapLayer="Tribenet Obstacles" style="null" fontFace="null" color="%s" outlineColor="1.0,1.0,1.0,1.0" outlineSize="0.0" rotate="0.0" isBold="false" isItalic="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" isGMOnly="false" tags="">`, obstacle.color)
+				w.Printf(`<location viewLevel="WORLD" x="%g" y="%g" scale="3.125" />`, labelXY.X, labelXY.Y)
+				w.Printf("%s", strings.Join(obstacle.movers, " "))
+				w.Printf("</label>\n")
+			}
 		}
 	}
 
+	w.writeTrailLabels(renderOffset)
+
 	w.Printf("</labels>\n")
 
 	w.Println(`<shapes>`)
@@ -462,12 +660,12 @@ func (w *WXX) Create(path string, turnId string, upperLeft, lowerRight coords.Ma
 				midpointFrom := midpoint(from, ford)
 				midpointTo := midpoint(to, ford)
 
-				w.Printf(`<shape  type="Path" isCurve="false" isGMOnly="false" isSnapVertices="true" isMatchTileBorders="false" tags="" creationType="BASIC" isDropShadow="false" isInnerShadow="false" isBoxBlur="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" dsSpread="0.2" dsRadius="50.0" dsOffsetX="0.0" dsOffsetY="0.0" insChoke="0.2" insRadius="50.0" insOffsetX="0.0" insOffsetY="0.0" bbWidth="10.0" bbHeight="10.0" bbIterations="3" mapLayer="Above Terrain" fillTexture="" strokeTexture="" strokeType="SIMPLE" highestViewLevel="WORLD" currentShapeViewLevel="WORLD" lineCap="ROUND" lineJoin="ROUND" opacity="1.0" fillRule="NON_ZERO" strokeColor="0.6000000238418579,0.800000011920929,1.0,1.0" strokeWidth="%f" dsColor="1.0,0.8941176533699036,0.7686274647712708,1.0" insColor="1.0,0.8941176533699036,0.7686274647712708,1.0">`, riverWidth)
+				w.Printf(`<shape  type="Path" isCurve="false" isGMOnly="false" isSnapVertices="true" isMatchTileBorders="false" tags="ottomap" creationType="BASIC" isDropShadow="false" isInnerShadow="false" isBoxBlur="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" dsSpread="0.2" dsRadius="50.0" dsOffsetX="0.0" dsOffsetY="0.0" insChoke="0.2" insRadius="50.0" insOffsetX="0.0" insOffsetY="0.0" bbWidth="10.0" bbHeight="10.0" bbIterations="3" mapLayer="Above Terrain" fillTexture="" strokeTexture="" strokeType="SIMPLE" highestViewLevel="WORLD" currentShapeViewLevel="WORLD" lineCap="ROUND" lineJoin="ROUND" opacity="1.0" fillRule="NON_ZERO" strokeColor="0.6000000238418579,0.800000011920929,1.0,1.0" strokeWidth="%f" dsColor="1.0,0.8941176533699036,0.7686274647712708,1.0" insColor="1.0,0.8941176533699036,0.7686274647712708,1.0">`, riverWidth)
 				w.Printf(` <p type="m" x="%f" y="%f"/>`, from.X, from.Y)
 				w.Printf(` <p x="%f" y="%f"/>`, midpointFrom.X, midpointFrom.Y)
 				w.Println(`</shape>`)
 
-				w.Printf(`<shape  type="Path" isCurve="false" isGMOnly="false" isSnapVertices="true" isMatchTileBorders="false" tags="" creationType="BASIC" isDropShadow="false" isInnerShadow="false" isBoxBlur="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" dsSpread="0.2" dsRadius="50.0" dsOffsetX="0.0" dsOffsetY="0.0" insChoke="0.2" insRadius="50.0" insOffsetX="0.0" insOffsetY="0.0" bbWidth="10.0" bbHeight="10.0" bbIterations="3" mapLayer="Above Terrain" fillTexture="" strokeTexture="" strokeType="SIMPLE" highestViewLevel="WORLD" currentShapeViewLevel="WORLD" lineCap="ROUND" lineJoin="ROUND" opacity="1.0" fillRule="NON_ZERO" strokeColor="0.6000000238418579,0.800000011920929,1.0,1.0" strokeWidth="%f" dsColor="1.0,0.8941176533699036,0.7686274647712708,1.0" insColor="1.0,0.8941176533699036,0.7686274647712708,1.0">`, riverWidth)
+				w.Printf(`<shape  type="Path" isCurve="false" isGMOnly="false" isSnapVertices="true" isMatchTileBorders="false" tags="ottomap" creationType="BASIC" isDropShadow="false" isInnerShadow="false" isBoxBlur="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" dsSpread="0.2" dsRadius="50.0" dsOffsetX="0.0" dsOffsetY="0.0" insChoke="0.2" insRadius="50.0" insOffsetX="0.0" insOffsetY="0.0" bbWidth="10.0" bbHeight="10.0" bbIterations="3" mapLayer="Above Terrain" fillTexture="" strokeTexture="" strokeType="SIMPLE" highestViewLevel="WORLD" currentShapeViewLevel="WORLD" lineCap="ROUND" lineJoin="ROUND" opacity="1.0" fillRule="NON_ZERO" strokeColor="0.6000000238418579,0.800000011920929,1.0,1.0" strokeWidth="%f" dsColor="1.0,0.8941176533699036,0.7686274647712708,1.0" insColor="1.0,0.8941176533699036,0.7686274647712708,1.0">`, riverWidth)
 				w.Printf(` <p type="m" x="%f" y="%f"/>`, midpointTo.X, midpointTo.Y)
 				w.Printf(` <p x="%f" y="%f"/>`, to.X, to.Y)
 				w.Println(`</shape>`)
@@ -494,7 +692,7 @@ func (w *WXX) Create(path string, turnId string, upperLeft, lowerRight coords.Ma
 				default:
 					panic(fmt.Sprintf("assert(direction != %d)", dir))
 				}
-				w.Printf(`<shape  type="Path" isCurve="false" isGMOnly="false" isSnapVertices="true" isMatchTileBorders="false" tags="" creationType="BASIC" isDropShadow="false" isInnerShadow="false" isBoxBlur="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" dsSpread="0.2" dsRadius="50.0" dsOffsetX="0.0" dsOffsetY="0.0" insChoke="0.2" insRadius="50.0" insOffsetX="0.0" insOffsetY="0.0" bbWidth="10.0" bbHeight="10.0" bbIterations="3" mapLayer="Above Terrain" fillTexture="" strokeTexture="" strokeType="SIMPLE" highestViewLevel="WORLD" currentShapeViewLevel="WORLD" lineCap="ROUND" lineJoin="ROUND" opacity="1.0" fillRule="NON_ZERO" strokeColor="0.6000000238418579,0.800000011920929,1.0,1.0" strokeWidth="%f" dsColor="1.0,0.8941176533699036,0.7686274647712708,1.0" insColor="1.0,0.8941176533699036,0.7686274647712708,1.0">`, riverWidth)
+				w.Printf(`<shape  type="Path" isCurve="false" isGMOnly="false" isSnapVertices="true" isMatchTileBorders="false" tags="ottomap" creationType="BASIC" isDropShadow="false" isInnerShadow="false" isBoxBlur="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" dsSpread="0.2" dsRadius="50.0" dsOffsetX="0.0" dsOffsetY="0.0" insChoke="0.2" insRadius="50.0" insOffsetX="0.0" insOffsetY="0.0" bbWidth="10.0" bbHeight="10.0" bbIterations="3" mapLayer="Above Terrain" fillTexture="" strokeTexture="" strokeType="SIMPLE" highestViewLevel="WORLD" currentShapeViewLevel="WORLD" lineCap="ROUND" lineJoin="ROUND" opacity="1.0" fillRule="NON_ZERO" strokeColor="0.6000000238418579,0.800000011920929,1.0,1.0" strokeWidth="%f" dsColor="1.0,0.8941176533699036,0.7686274647712708,1.0" insColor="1.0,0.8941176533699036,0.7686274647712708,1.0">`, riverWidth)
 				w.Printf(` <p type="m" x="%f" y="%f"/>`, from.X, from.Y)
 				w.Printf(` <p x="%f" y="%f"/>`, to.X, to.Y)
 				w.Println(`</shape>`)
@@ -508,7 +706,7 @@ func (w *WXX) Create(path string, turnId string, upperLeft, lowerRight coords.Ma
 				segmentEnd := edgeCenter(dir, points)
 				segmentStart := midpoint(midpoint(center, segmentEnd), segmentEnd)
 
-				w.Printf(`<shape  type="Path" isCurve="false" isGMOnly="false" isSnapVertices="true" isMatchTileBorders="false" tags="" creationType="BASIC" isDropShadow="false" isInnerShadow="false" isBoxBlur="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" dsSpread="0.2" dsRadius="50.0" dsOffsetX="0.0" dsOffsetY="0.0" insChoke="0.2" insRadius="50.0" insOffsetX="0.0" insOffsetY="0.0" bbWidth="10.0" bbHeight="10.0" bbIterations="3" mapLayer="Above Terrain" fillTexture="" strokeTexture="" strokeType="SIMPLE" highestViewLevel="WORLD" currentShapeViewLevel="WORLD" lineCap="ROUND" lineJoin="ROUND" opacity="1.0" fillRule="NON_ZERO" fillColor="%g,%g,%g,1.0" strokeColor="%g,%g,%g,1.0" strokeWidth="0.09" dsColor="1.0,0.8941176533699036,0.7686274647712708,1.0" insColor="1.0,0.8941176533699036,0.7686274647712708,1.0">`, mountainPass.R, mountainPass.G, mountainPass.B, mountainPass.R, mountainPass.G, mountainPass.B)
+				w.Printf(`<shape  type="Path" isCurve="false" isGMOnly="false" isSnapVertices="true" isMatchTileBorders="false" tags="ottomap" creationType="BASIC" isDropShadow="false" isInnerShadow="false" isBoxBlur="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" dsSpread="0.2" dsRadius="50.0" dsOffsetX="0.0" dsOffsetY="0.0" insChoke="0.2" insRadius="50.0" insOffsetX="0.0" insOffsetY="0.0" bbWidth="10.0" bbHeight="10.0" bbIterations="3" mapLayer="Above Terrain" fillTexture="" strokeTexture="" strokeType="SIMPLE" highestViewLevel="WORLD" currentShapeViewLevel="WORLD" lineCap="ROUND" lineJoin="ROUND" opacity="1.0" fillRule="NON_ZERO" fillColor="%g,%g,%g,1.0" strokeColor="%g,%g,%g,1.0" strokeWidth="0.09" dsColor="1.0,0.8941176533699036,0.7686274647712708,1.0" insColor="1.0,0.8941176533699036,0.7686274647712708,1.0">`, mountainPass.R, mountainPass.G, mountainPass.B, mountainPass.R, mountainPass.G, mountainPass.B)
 				w.Printf(` <p type="m" x="%f" y="%f"/>`, segmentStart.X, segmentStart.Y)
 				w.Printf(` <p x="%f" y="%f"/>`, segmentEnd.X, segmentEnd.Y)
 				w.Println(`</shape>`)
@@ -522,14 +720,26 @@ func (w *WXX) Create(path string, turnId string, upperLeft, lowerRight coords.Ma
 				segmentEnd := edgeCenter(dir, points)
 				segmentStart := midpoint(midpoint(center, segmentEnd), segmentEnd)
 
-				w.Printf(`<shape  type="Path" isCurve="false" isGMOnly="false" isSnapVertices="true" isMatchTileBorders="false" tags="" creationType="BASIC" isDropShadow="false" isInnerShadow="false" isBoxBlur="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" dsSpread="0.2" dsRadius="50.0" dsOffsetX="0.0" dsOffsetY="0.0" insChoke="0.2" insRadius="50.0" insOffsetX="0.0" insOffsetY="0.0" bbWidth="10.0" bbHeight="10.0" bbIterations="3" mapLayer="Above Terrain" fillTexture="" strokeTexture="" strokeType="SIMPLE" highestViewLevel="WORLD" currentShapeViewLevel="WORLD" lineCap="ROUND" lineJoin="ROUND" opacity="1.0" fillRule="NON_ZERO" fillColor="0.7019608020782471,0.7019608020782471,0.7019608020782471,1.0" strokeColor="0.7019608020782471,0.7019608020782471,0.7019608020782471,1.0" strokeWidth="0.05" dsColor="1.0,0.8941176533699036,0.7686274647712708,1.0" insColor="1.0,0.8941176533699036,0.7686274647712708,1.0">`)
+				w.Printf(`<shape  type="Path" isCurve="false" isGMOnly="false" isSnapVertices="true" isMatchTileBorders="false" tags="ottomap" creationType="BASIC" isDropShadow="false" isInnerShadow="false" isBoxBlur="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" dsSpread="0.2" dsRadius="50.0" dsOffsetX="0.0" dsOffsetY="0.0" insChoke="0.2" insRadius="50.0" insOffsetX="0.0" insOffsetY="0.0" bbWidth="10.0" bbHeight="10.0" bbIterations="3" mapLayer="Above Terrain" fillTexture="" strokeTexture="" strokeType="SIMPLE" highestViewLevel="WORLD" currentShapeViewLevel="WORLD" lineCap="ROUND" lineJoin="ROUND" opacity="1.0" fillRule="NON_ZERO" fillColor="0.7019608020782471,0.7019608020782471,0.7019608020782471,1.0" strokeColor="0.7019608020782471,0.7019608020782471,0.7019608020782471,1.0" strokeWidth="0.05" dsColor="1.0,0.8941176533699036,0.7686274647712708,1.0" insColor="1.0,0.8941176533699036,0.7686274647712708,1.0">`)
 				w.Printf(` <p type="m" x="%f" y="%f"/>`, segmentStart.X, segmentStart.Y)
 				w.Printf(` <p x="%f" y="%f"/>`, segmentEnd.X, segmentEnd.Y)
 				w.Println(`</shape>`)
 			}
+
+			// obstacles are drawn just inside the edge that stopped the unit
+			for _, obstacle := range obstaclesByDirection(t.Features.Obstacles) {
+				from, to := GetCenteredHalfSegment(edgeVertices(obstacle.direction, points))
+				from, to = midpoint(from, midpoint(from, points[0])), midpoint(to, midpoint(to, points[0]))
+				w.Printf(`<shape  type="Path" isCurve="false" isGMOnly="false" isSnapVertices="true" isMatchTileBorders="false" tags="" creationType="BASIC" isDropShadow="false" isInnerShadow="false" isBoxBlur="false" isWorld="true" isContinent="true" isKingdom="true" isProvince="true" dsSpread="0.2" dsRadius="50.0" dsOffsetX="0.0" dsOffsetY="0.0" insChoke="0.2" insRadius="50.0" insOffsetX="0.0" insOffsetY="0.0" bbWidth="10.0" bbHeight="10.0" bbIterations="3" mapLayer="Tribenet Obstacles" fillTexture="" strokeTexture="" strokeType="SIMPLE" highestViewLevel="WORLD" currentShapeViewLevel="WORLD" lineCap="ROUND" lineJoin="ROUND" opacity="1.0" fillRule="NON_ZERO" strokeColor="%s" strokeWidth="0.05" dsColor="1.0,0.8941176533699036,0.7686274647712708,1.0" insColor="1.0,0.8941176533699036,0.7686274647712708,1.0">`, obstacle.color)
+				w.Printf(` <p type="m" x="%f" y="%f"/>`, from.X, from.Y)
+				w.Printf(` <p x="%f" y="%f"/>`, to.X, to.Y)
+				w.Println(`</shape>`)
+			}
 		}
 	}
 
+	w.writeTrailShapes(renderOffset)
+
 	w.Println(`</shapes>`)
 
 	w.Println(`<notes>`)
@@ -565,6 +775,10 @@ func (w *WXX) Create(path string, turnId string, upperLeft, lowerRight coords.Ma
 
 	//fmt.Printf("%s\n", w.buffer.String())
 
+	if err := w.mergeAnnotations(); err != nil {
+		return err
+	}
+
 	// convert the source from UTF-8 to UTF-16
 	var buf16 bytes.Buffer
 	buf16.Write([]byte{0xfe, 0xff}) // write the BOM
@@ -740,3 +954,32 @@ func pointy_hex_to_pixel(a Axial) Point {
 		Y: 3 * a.R / 2,
 	}
 }
+
+// obstacleEdge_t is the obstacles on a single edge of a tile.
+type obstacleEdge_t struct {
+	direction direction.Direction_e
+	movers    []string // types of units that were stopped
+	color     string   // red if the edge can't be crossed, orange if the units were only exhausted
+}
+
+// obstaclesByDirection groups the obstacles by the edge they are on.
+func obstaclesByDirection(obstacles []*parser.Obstacle_t) (list []*obstacleEdge_t) {
+	for _, d := range direction.Directions {
+		edge := &obstacleEdge_t{direction: d, color: "1.0,0.6000000238418579,0.0,1.0"}
+		for _, o := range obstacles {
+			if o.Direction != d {
+				continue
+			}
+			if o.Result != results.ExhaustedMovementPoints {
+				edge.color = "1.0,0.0,0.0,1.0"
+			}
+			if mover := o.Mover(); !slices.Contains(edge.movers, mover) {
+				edge.movers = append(edge.movers, mover)
+			}
+		}
+		if len(edge.movers) != 0 {
+			list = append(list, edge)
+		}
+	}
+	return list
+}
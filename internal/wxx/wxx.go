@@ -11,6 +11,10 @@ type WXX struct {
 	buffer *bytes.Buffer
 
 	tiles map[coords.Map]*Tile
+
+	trails []*Trail // unit trails to draw on the map
+
+	annotations *Document // optional annotations to keep from an existing map
 }
 
 func NewWXX() *WXX {
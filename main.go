@@ -4,12 +4,16 @@
 package main
 
 import (
+	"fmt"
+	"github.com/mdhender/ottomap/actions"
 	"github.com/mdhender/ottomap/cerrs"
+	"github.com/mdhender/ottomap/internal/raster"
 	"github.com/mdhender/semver"
 	"github.com/spf13/cobra"
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 var (
@@ -19,15 +23,29 @@ var (
 func main() {
 	log.SetFlags(log.Lshortfile | log.Ltime)
 
-	// todo: detect when a unit is created as an after-move action
-
 	if err := Execute(); err != nil {
 		log.Fatal(err)
 	}
 }
 
 func Execute() error {
-	cmdRoot.AddCommand(cmdRender, cmdServe, cmdVersion)
+	cmdRoot.AddCommand(cmdCalendar, cmdDiff, cmdRender, cmdRoute, cmdServe, cmdUnit, cmdVersion)
+
+	cmdCalendar.Flags().StringVar(&argsCalendar.paths.data, "data", "data", "path to root of data files")
+
+	cmdDiff.Flags().BoolVar(&argsDiff.wxx, "wxx", false, "also create a map with only the changes")
+	cmdDiff.Flags().StringSliceVar(&argsDiff.allies, "allies", nil, "allied clans to show as friendly (0992,0993)")
+	cmdDiff.Flags().StringVar(&argsDiff.clanId, "clan-id", "", "clan for output file names")
+	if err := cmdDiff.MarkFlagRequired("clan-id"); err != nil {
+		log.Fatalf("error: clan-id: %v\n", err)
+	}
+	cmdDiff.Flags().StringVar(&argsDiff.paths.data, "data", "data", "path to root of data files")
+	cmdDiff.Flags().StringVar(&argsDiff.from, "from", "", "last turn of the earlier map (yyyy-mm format)")
+	if err := cmdDiff.MarkFlagRequired("from"); err != nil {
+		log.Fatalf("error: from: %v\n", err)
+	}
+	cmdDiff.Flags().StringVar(&argsDiff.originGrid, "origin-grid", "", "grid id for ## when it can't be inferred")
+	cmdDiff.Flags().StringVar(&argsDiff.to, "to", "", "last turn of the later map (yyyy-mm format, default is the last turn)")
 
 	cmdRender.Flags().BoolVar(&argsRender.debug.dumpAllTiles, "debug-dump-all-tiles", false, "dump all tiles")
 	cmdRender.Flags().BoolVar(&argsRender.debug.dumpAllTurns, "debug-dump-all-turns", false, "dump all turns")
@@ -36,23 +54,52 @@ func Execute() error {
 	cmdRender.Flags().BoolVar(&argsRender.debug.parser, "debug-parser", false, "enable parser debugging")
 	cmdRender.Flags().BoolVar(&argsRender.debug.sections, "debug-sections", false, "enable sections debugging")
 	cmdRender.Flags().BoolVar(&argsRender.debug.steps, "debug-steps", false, "enable step debugging")
+	cmdRender.Flags().BoolVar(&argsRender.errorsJson, "errors-json", false, "write errors to a JSON file in the output folder")
+	cmdRender.Flags().BoolVar(&argsRender.incremental, "incremental", false, "only parse reports that changed since the last run")
 	cmdRender.Flags().BoolVar(&argsRender.experimental.stripCR, "debug-strip-cr", false, "experimental: enable conversion of DOS EOL")
 	cmdRender.Flags().BoolVar(&argsRender.experimental.splitTrailingUnits, "x-split-units", false, "experimental: split trailing units")
+	cmdRender.Flags().BoolVar(&argsRender.render.KeepAnnotations, "merge-wxx", false, "keep the annotations from the existing map file")
 	cmdRender.Flags().BoolVar(&argsRender.mapper.Dump.BorderCounts, "dump-border-counts", false, "dump border counts")
+	cmdRender.Flags().BoolVar(&argsRender.perClan, "per-clan", false, "also create a map for each clan from its own reports")
 	cmdRender.Flags().BoolVar(&argsRender.parser.Ignore.Scouts, "ignore-scouts", false, "ignore scout reports")
 	cmdRender.Flags().BoolVar(&argsRender.noWarnOnInvalidGrid, "no-warn-on-invalid-grid", false, "disable grid id warnings")
 	cmdRender.Flags().BoolVar(&argsRender.render.Show.Grid.Coords, "show-grid-coords", false, "show grid coordinates (XX CCRR)")
 	cmdRender.Flags().BoolVar(&argsRender.render.Show.Grid.Numbers, "show-grid-numbers", false, "show grid numbers (CCRR)")
+	cmdRender.Flags().BoolVar(&argsRender.render.Show.Staleness, "show-staleness", false, "label hexes with the turn they were last visited or scouted")
 	cmdRender.Flags().BoolVar(&argsRender.saveWithTurnId, "save-with-turn-id", false, "add turn id to file name")
 	cmdRender.Flags().BoolVar(&argsRender.show.origin, "show-origin", false, "show origin hex")
 	cmdRender.Flags().BoolVar(&argsRender.show.shiftMap, "shift-map", false, "shift map up and left")
+	cmdRender.Flags().BoolVar(&argsRender.useAtlas, "use-atlas", false, "load and save the world map atlas; only turns newer than the atlas are walked")
+	cmdRender.Flags().IntVar(&argsRender.render.PixelsPerHex, "pixels-per-hex", raster.DefaultPixelsPerHex, "width of a hex on png maps")
+	cmdRender.Flags().StringSliceVar(&argsRender.allies, "allies", nil, "allied clans to show as friendly (0992,0993)")
 	cmdRender.Flags().StringVar(&argsRender.clanId, "clan-id", "", "clan for output file names")
 	if err := cmdRender.MarkFlagRequired("clan-id"); err != nil {
 		log.Fatalf("error: clan-id: %v\n", err)
 	}
 	cmdRender.Flags().StringVar(&argsRender.paths.data, "data", "data", "path to root of data files")
-	cmdRender.Flags().StringVar(&argsRender.originGrid, "origin-grid", "", "grid id to substitute for ##")
-	cmdRender.Flags().StringVar(&argsRender.maxTurn.id, "max-turn", "", "last turn to map (yyyy-mm format)")
+	cmdRender.Flags().StringVar(&argsRender.originGrid, "origin-grid", "", "grid id for ## when it can't be inferred")
+	cmdRender.Flags().StringVar(&argsRender.format, "format", actions.Formats[0], fmt.Sprintf("format of the map (%s)", strings.Join(actions.Formats, ", ")))
+	cmdRender.Flags().StringVar(&argsRender.maxTurnId, "max-turn", "", "last turn to map (yyyy-mm format)")
+	cmdRender.Flags().StringVar(&argsRender.trails, "trails", "", "draw unit trails on a layer for each \"unit\" or unit \"type\"")
+
+	cmdRoute.Flags().StringVar(&argsRoute.clanId, "clan-id", "", "clan that owns the atlas and turn reports")
+	if err := cmdRoute.MarkFlagRequired("clan-id"); err != nil {
+		log.Fatalf("error: clan-id: %v\n", err)
+	}
+	cmdRoute.Flags().StringVar(&argsRoute.paths.data, "data", "data", "path to root of data files")
+	cmdRoute.Flags().StringVar(&argsRoute.from, "from", "", "hex to start from (XX CCRR)")
+	if err := cmdRoute.MarkFlagRequired("from"); err != nil {
+		log.Fatalf("error: from: %v\n", err)
+	}
+	cmdRoute.Flags().StringVar(&argsRoute.originGrid, "origin-grid", "", "grid for obscured locations that can't be inferred")
+	cmdRoute.Flags().StringVar(&argsRoute.to, "to", "", "hex to end at (XX CCRR)")
+	if err := cmdRoute.MarkFlagRequired("to"); err != nil {
+		log.Fatalf("error: to: %v\n", err)
+	}
+
+	cmdUnit.Flags().StringVar(&argsUnit.paths.data, "data", "data", "path to root of data files")
+	cmdUnit.Flags().BoolVar(&argsUnit.json, "json", false, "print the history as JSON")
+	cmdUnit.Flags().StringVar(&argsUnit.originGrid, "origin-grid", "", "grid for obscured locations that can't be inferred")
 
 	cmdServe.Flags().StringVar(&argsServe.paths.assets, "assets", "assets", "path to public assets")
 	cmdServe.Flags().StringVar(&argsServe.paths.data, "data", "userdata", "path to root of user data files")
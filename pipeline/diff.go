@@ -0,0 +1,72 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package pipeline
+
+import (
+	"fmt"
+	"github.com/mdhender/ottomap/actions"
+	"github.com/mdhender/ottomap/internal/tiles"
+	"log"
+)
+
+// DiffResult holds the changes that the turns after the From turn revealed.
+type DiffResult struct {
+	From    string            // last turn of the earlier map
+	To      string            // last turn of the later map
+	Changes []*tiles.Change_t // sorted by location
+	MapPath string            // path to the map of the changes, if one was written
+}
+
+// Diff walks the reports twice, once through fromTurnId and once through
+// opts.MaxTurnId, and returns the changes between the two maps.
+//
+// If opts.Format is set, a map with only the changed hexes is created.
+// Like Walk, it returns ErrDiagnostics along with the result of the walk
+// that found the errors.
+func Diff(opts Options, fromTurnId string) (*DiffResult, *Result, error) {
+	if opts.UseAtlas {
+		return nil, nil, fmt.Errorf("diff can't be used with the atlas")
+	} else if opts.MaxTurnId != "" && opts.MaxTurnId <= fromTurnId {
+		return nil, nil, fmt.Errorf("turn %q must be after turn %q", opts.MaxTurnId, fromTurnId)
+	}
+	var renderer actions.Renderer
+	if opts.Format != "" {
+		var err error
+		if renderer, err = actions.NewRenderer(opts.Format, opts.Render); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	fromOpts := opts
+	fromOpts.MaxTurnId = fromTurnId
+	from, err := Walk(fromOpts)
+	if err != nil {
+		return nil, from, err
+	}
+	to, err := Walk(opts)
+	if err != nil {
+		return nil, to, err
+	}
+
+	d := &DiffResult{From: fromTurnId, To: to.TurnId}
+	var changed *tiles.Map_t
+	d.Changes, changed = tiles.Diff(from.WorldMap, to.WorldMap)
+	log.Printf("diff: %s to %s: %d changes in %d hexes\n", d.From, d.To, len(d.Changes), changed.Length())
+
+	if renderer == nil || changed.Length() == 0 {
+		return d, to, nil
+	}
+	hexMap, err := actions.MapWorld(changed, opts.clans(), opts.Mapper)
+	if err != nil {
+		return d, to, err
+	}
+	hexMap.TurnId = d.To
+	mapName := opts.mapPath(d.To, opts.ClanId, fmt.Sprintf(".diff.%s.%s", d.From, d.To), renderer.Extension())
+	if err := renderer.Render(mapName, hexMap); err != nil {
+		return d, to, fmt.Errorf("%s: %w", mapName, err)
+	}
+	d.MapPath = mapName
+	log.Printf("created  %s\n", mapName)
+
+	return d, to, nil
+}
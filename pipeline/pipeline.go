@@ -0,0 +1,153 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+// Package pipeline implements the steps to create a map from the turn reports.
+//
+// The render command is a thin wrapper around Render. The other commands,
+// the web application, and the tests call Render directly instead of running
+// the CLI.
+//
+// Render collects and parses the turn reports, consolidates the reports for
+// each turn, links the turns, walks the moves to build the world map, and
+// writes the map to the output folder in the format from the options.
+//
+// This package is not supported for use by other programs. Options and
+// Result hold types from the internal packages (the parsed turns, the world
+// map, the diagnostics, and the parser, mapper, and render settings), and
+// those types may change at any time. Programs that need to read turn
+// reports should use pkg/reports instead.
+package pipeline
+
+import (
+	"fmt"
+	"github.com/mdhender/ottomap/actions"
+	"github.com/mdhender/ottomap/cerrs"
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/diagnostics"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/tiles"
+	"github.com/mdhender/ottomap/internal/turns"
+	"github.com/mdhender/ottomap/internal/wxx"
+	"path/filepath"
+)
+
+const (
+	// ErrDiagnostics is returned when the reports have errors.
+	// The errors are in the diagnostics in the result.
+	ErrDiagnostics = cerrs.Error("errors in turn reports")
+)
+
+// Options holds the settings for a single run of the pipeline.
+type Options struct {
+	ClanId     string // clan for output file names, for example "0991"
+	InputPath  string // path to the folder with the turn report files
+	OutputPath string // path to the folder for the map and other output files
+
+	// Allies are the other clans in the alliance, for example "0992".
+	// Their units are shown as friendly on the map.
+	Allies []string
+	// PerClan writes a map for each clan, built from only that clan's reports,
+	// along with the combined map. It can't be used with UseAtlas.
+	PerClan bool
+
+	// MaxTurnId is the last turn to map, in yyyy-mm format.
+	// If it is empty, all the turns are mapped.
+	MaxTurnId string
+
+	// OriginGrid is used for "##" in obscured locations when the grid
+	// can't be inferred from the clan's later locations.
+	OriginGrid        string
+	QuitOnInvalidGrid bool
+	WarnOnInvalidGrid bool
+
+	// UseAtlas loads and saves the world map between runs. Only the turns
+	// newer than the atlas are parsed, so Result.Turns doesn't include the
+	// turns in the atlas. It can't be used with Trails or ShowOrigin.
+	UseAtlas bool
+
+	Incremental    bool // only parse reports that changed since the last run
+	ErrorsJson     bool // write the diagnostics to a JSON file
+	SaveWithTurnId bool // add the turn id to the name of the map file
+	ShowOrigin     bool // put a marker in the origin hex
+	ShiftMap       bool // shift the map up and left
+
+	// Trails draws the path of each unit on its own layer or on a layer
+	// for each type of unit. The default is not to draw them.
+	Trails actions.TrailLayers_e
+
+	// Format is the output format for the map; see actions.Formats.
+	// If it is empty, a Worldographer map is created.
+	Format string
+
+	Parser parser.ParseConfig
+	Mapper actions.MapConfig
+	Render wxx.RenderConfig
+
+	Experimental struct {
+		SplitTrailingUnits bool
+		StripCR            bool
+	}
+	Debug struct {
+		DumpAllTiles bool
+		DumpAllTurns bool
+		Maps         bool
+		Nodes        bool
+		Parser       bool
+		Sections     bool
+		Steps        bool
+	}
+}
+
+// Result holds the results from a run of the pipeline.
+// Paths are empty if the file was not written.
+type Result struct {
+	TurnId      string                         // last turn mapped
+	Turns       []*parser.Turn_t               // consolidated turns, sorted by turn; only those newer than the atlas if UseAtlas is set
+	WorldMap    *tiles.Map_t                   // the world map after the walk
+	LastSeen    map[parser.UnitId_t]coords.Map // last location of each unit
+	Diagnostics *diagnostics.Collector_t       // problems found in the reports
+
+	MapPath    string            // path to the map
+	ClanPaths  map[string]string // path to the map for each clan, if PerClan is set
+	AtlasPath  string            // path to the atlas
+	ItemsPath  string            // path to the list of items found
+	ErrorsPath string            // path to the diagnostics file
+
+	reportFiles map[string]string // file name for each report id
+}
+
+// clans returns the clan and its allies.
+func (o *Options) clans() (list []parser.UnitId_t) {
+	list = append(list, parser.UnitId_t(o.ClanId))
+	for _, ally := range o.Allies {
+		if ally != o.ClanId {
+			list = append(list, parser.UnitId_t(ally))
+		}
+	}
+	return list
+}
+
+// atlasPath returns the path to the atlas for the clan.
+func (o *Options) atlasPath() string {
+	return filepath.Join(o.OutputPath, fmt.Sprintf("%s.atlas.json", o.ClanId))
+}
+
+// mapPath returns the path to the map file for the clan.
+// The suffix is added before the extension, for example "0991.own.wxx".
+func (o *Options) mapPath(turnId, clanId, suffix, extension string) string {
+	name := clanId + suffix + extension
+	if o.SaveWithTurnId {
+		name = turnId + "." + name
+	}
+	return filepath.Join(o.OutputPath, name)
+}
+
+// maxTurn returns the year and month of the last turn to map.
+func (o *Options) maxTurn() (year, month int, err error) {
+	if o.MaxTurnId == "" {
+		return 9999, 12, nil
+	}
+	if year, month, err = turns.ParseTurnId(o.MaxTurnId); err != nil {
+		return 0, 0, fmt.Errorf("max turn %q: %w", o.MaxTurnId, err)
+	}
+	return year, month, nil
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package pipeline_test
+
+import (
+	"bytes"
+	"errors"
+	"github.com/mdhender/ottomap/actions"
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/terrain"
+	"github.com/mdhender/ottomap/internal/wxx"
+	"github.com/mdhender/ottomap/pipeline"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	output := t.TempDir()
+	opts := pipeline.Options{
+		ClanId:            "0991",
+		InputPath:         filepath.Join("..", "data", "input"),
+		OutputPath:        output,
+		OriginGrid:        "RR",
+		WarnOnInvalidGrid: true,
+		UseAtlas:          true,
+	}
+	// the reports don't have any items, so the list from an earlier run must be removed
+	itemsName := filepath.Join(output, "0991.items.txt")
+	if err := os.WriteFile(itemsName, []byte("stale\n"), 0644); err != nil {
+		t.Fatalf("items: %v", err)
+	}
+	r, err := pipeline.Render(opts)
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if r.TurnId != "0900-01" {
+		t.Errorf("turn: want %q, got %q", "0900-01", r.TurnId)
+	}
+	if len(r.Turns) != 2 {
+		t.Errorf("turns: want 2, got %d", len(r.Turns))
+	}
+	if r.WorldMap == nil || r.WorldMap.Length() == 0 {
+		t.Errorf("world map: want tiles, got none")
+	}
+	if r.Diagnostics.Errors() != 0 {
+		t.Errorf("diagnostics: want 0 errors, got %d", r.Diagnostics.Errors())
+	}
+	for _, path := range []string{r.MapPath, r.AtlasPath} {
+		if path == "" {
+			t.Errorf("output: want path, got none")
+		} else if _, err := os.Stat(path); err != nil {
+			t.Errorf("output: %v", err)
+		}
+	}
+	if _, err := os.Stat(itemsName); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("items: want stale file removed, got %v", err)
+	} else if r.ItemsPath != "" {
+		t.Errorf("items: want no path, got %q", r.ItemsPath)
+	}
+
+	// the second run starts from the atlas, so it has the map but not the turns
+	tiles := r.WorldMap.Length()
+	if r, err = pipeline.Render(opts); err != nil {
+		t.Fatalf("atlas: render: %v", err)
+	}
+	if r.TurnId != "0900-01" || len(r.Turns) != 0 {
+		t.Errorf("atlas: want turn 0900-01 and no turns, got %q and %d turns", r.TurnId, len(r.Turns))
+	}
+	if r.WorldMap == nil {
+		t.Errorf("atlas: want %d tiles, got no map", tiles)
+	} else if r.WorldMap.Length() != tiles {
+		t.Errorf("atlas: want %d tiles, got %d", tiles, r.WorldMap.Length())
+	}
+}
+
+func TestRenderFormat(t *testing.T) {
+	opts := pipeline.Options{
+		ClanId:     "0991",
+		InputPath:  filepath.Join("..", "data", "input"),
+		OutputPath: t.TempDir(),
+		Format:     "docx",
+	}
+	if _, err := pipeline.Render(opts); err == nil {
+		t.Errorf("format: want error, got nil")
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	// bad input must be returned as an error instead of ending the program
+	for _, tc := range []struct {
+		id     string
+		input  string
+		max    string
+		atlas  bool
+		trails actions.TrailLayers_e
+		origin bool
+	}{
+		{id: "missing input folder", input: filepath.Join(t.TempDir(), "missing")},
+		{id: "no reports", input: t.TempDir()},
+		{id: "invalid max turn", input: filepath.Join("..", "data", "input"), max: "0900-13"},
+		{id: "trails with atlas", input: filepath.Join("..", "data", "input"), atlas: true, trails: actions.TrailsByUnit},
+		{id: "origin with atlas", input: filepath.Join("..", "data", "input"), atlas: true, origin: true},
+	} {
+		opts := pipeline.Options{
+			ClanId:     "0991",
+			InputPath:  tc.input,
+			OutputPath: t.TempDir(),
+			MaxTurnId:  tc.max,
+			OriginGrid: "RR",
+			UseAtlas:   tc.atlas,
+			Trails:     tc.trails,
+			ShowOrigin: tc.origin,
+		}
+		if _, err := pipeline.Render(opts); err == nil {
+			t.Errorf("%s: want error, got nil", tc.id)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	opts := pipeline.Options{
+		ClanId:            "0991",
+		InputPath:         filepath.Join("..", "data", "input"),
+		OutputPath:        t.TempDir(),
+		OriginGrid:        "RR",
+		WarnOnInvalidGrid: true,
+		Format:            "wxx",
+	}
+	d, _, err := pipeline.Diff(opts, "0899-12")
+	if err != nil {
+		t.Fatalf("diff: %v", err)
+	}
+	if d.To != "0900-01" {
+		t.Errorf("to: want %q, got %q", "0900-01", d.To)
+	}
+	if len(d.Changes) == 0 {
+		t.Errorf("changes: want changes, got none")
+	}
+	if _, err := os.Stat(d.MapPath); err != nil {
+		t.Errorf("map: %v", err)
+	}
+
+	// nothing changes after the last turn
+	if d, _, err = pipeline.Diff(opts, "0900-01"); err != nil {
+		t.Fatalf("diff: %v", err)
+	} else if len(d.Changes) != 0 {
+		t.Errorf("changes: want none, got %d", len(d.Changes))
+	} else if d.MapPath != "" {
+		t.Errorf("map: want none, got %q", d.MapPath)
+	}
+}
+
+func TestIncremental(t *testing.T) {
+	// copy the reports and break a line so that the parser has a warning
+	input := t.TempDir()
+	for _, name := range []string{"899-12.0991.report.txt", "900-01.0991.report.txt"} {
+		data, err := os.ReadFile(filepath.Join("..", "data", "input", name))
+		if err != nil {
+			t.Fatalf("read: %v", err)
+		}
+		data = bytes.Replace(data, []byte("People\t17670"), []byte("People\tmany"), 1)
+		if err := os.WriteFile(filepath.Join(input, name), data, 0644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	opts := pipeline.Options{
+		ClanId:            "0991",
+		InputPath:         input,
+		OutputPath:        t.TempDir(),
+		OriginGrid:        "RR",
+		WarnOnInvalidGrid: true,
+		Incremental:       true,
+	}
+
+	// the second run reads the reports from the cache and must report the same warnings
+	var warnings []int
+	for run := 1; run <= 2; run++ {
+		r, err := pipeline.Walk(opts)
+		if err != nil {
+			t.Fatalf("run %d: walk: %v", run, err)
+		}
+		warnings = append(warnings, r.Diagnostics.Warnings())
+	}
+	if warnings[0] == 0 {
+		t.Errorf("warnings: want warnings from the parser, got none")
+	} else if warnings[0] != warnings[1] {
+		t.Errorf("warnings: want %d on the cached run, got %d", warnings[0], warnings[1])
+	}
+}
+
+func TestAllies(t *testing.T) {
+	// the ally reports from a hex that the clan never visits
+	input := t.TempDir()
+	for _, name := range []string{"899-12.0991.report.txt", "900-01.0991.report.txt"} {
+		data, err := os.ReadFile(filepath.Join("..", "data", "input", name))
+		if err != nil {
+			t.Fatalf("read: %v", err)
+		} else if err := os.WriteFile(filepath.Join(input, name), data, 0644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	ally := "Tribe 0992, , Current Hex = MH 2020, (Previous Hex = MH 2020)\n" +
+		"Current Turn 900-01 (#1), Spring, FINE\tNext Turn 900-02 (#2), 12/11/2023\n" +
+		"Tribe Movement: Move \n" +
+		"0992 Status: SWAMP, 0992\n"
+	if err := os.WriteFile(filepath.Join(input, "900-01.0992.report.txt"), []byte(ally), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	output := t.TempDir()
+	opts := pipeline.Options{
+		ClanId:            "0991",
+		InputPath:         input,
+		OutputPath:        output,
+		Allies:            []string{"0992"},
+		PerClan:           true,
+		OriginGrid:        "RR",
+		WarnOnInvalidGrid: true,
+	}
+	opts.Render.Show.Grid.Coords = true
+	r, err := pipeline.Render(opts)
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	// the ally's hex is on the combined map
+	allyHex, err := coords.HexToMap("MH 2020")
+	if err != nil {
+		t.Fatalf("hex: %v", err)
+	}
+	if tile := r.WorldMap.Tiles[allyHex]; tile == nil || tile.Terrain != terrain.Swamp {
+		t.Errorf("world map: want ally's SW tile at MH 2020, got %v", tile)
+	}
+
+	// each clan's map is written to its own file and has only its own hexes
+	for _, tc := range []struct {
+		clan    string
+		allyHex bool
+	}{
+		{clan: "0991", allyHex: false},
+		{clan: "0992", allyHex: true},
+	} {
+		if want := filepath.Join(output, tc.clan+".own.wxx"); r.ClanPaths[tc.clan] != want {
+			t.Errorf("%s: path: want %q, got %q", tc.clan, want, r.ClanPaths[tc.clan])
+			continue
+		}
+		doc, err := wxx.Read(r.ClanPaths[tc.clan])
+		if err != nil {
+			t.Errorf("%s: read: %v", tc.clan, err)
+			continue
+		}
+		var labels int
+		var haveAllyHex bool
+		for _, e := range doc.Elements {
+			if e.Kind == "label" && e.MapLayer == "Tribenet Coords" {
+				labels++
+				haveAllyHex = haveAllyHex || e.Text == "MH 2020"
+			}
+		}
+		if labels == 0 {
+			t.Errorf("%s: want coordinate labels, got none", tc.clan)
+		} else if haveAllyHex != tc.allyHex {
+			t.Errorf("%s: MH 2020: want %v, got %v", tc.clan, tc.allyHex, haveAllyHex)
+		}
+	}
+}
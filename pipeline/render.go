@@ -0,0 +1,663 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package pipeline
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/mdhender/ottomap/actions"
+	"github.com/mdhender/ottomap/internal/atlas"
+	"github.com/mdhender/ottomap/internal/cache"
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/diagnostics"
+	"github.com/mdhender/ottomap/internal/edges"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/results"
+	"github.com/mdhender/ottomap/internal/terrain"
+	"github.com/mdhender/ottomap/internal/tiles"
+	"github.com/mdhender/ottomap/internal/turns"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Render creates the map from the turn reports in the input folder.
+//
+// It returns ErrDiagnostics if the reports have errors; the result holds
+// the diagnostics so that the caller can show them. Other errors are
+// returned for problems with the options or the input and output files.
+// Warnings do not stop the pipeline.
+func Render(opts Options) (*Result, error) {
+	started := time.Now()
+	if opts.PerClan && opts.UseAtlas {
+		// the atlas only has the combined map, so we can't walk each clan's reports from it
+		return nil, fmt.Errorf("per-clan maps can't be created from the atlas")
+	} else if opts.UseAtlas && (opts.Trails != actions.NoTrails || opts.ShowOrigin) {
+		// the atlas doesn't keep the turns it replaces, so the trails and origin would be incomplete
+		return nil, fmt.Errorf("trails and the origin hex can't be created from the atlas")
+	}
+	if len(opts.Allies) != 0 {
+		log.Printf("allies: %s\n", strings.Join(opts.Allies, ", "))
+	}
+	renderer, err := actions.NewRenderer(opts.Format, opts.Render)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := Walk(opts)
+	if r == nil {
+		return nil, err
+	} else if err != nil {
+		r.saveDiagnostics(opts)
+		return r, err
+	}
+	consolidatedTurns, worldMap, maxTurnId := r.Turns, r.WorldMap, r.TurnId
+
+	if opts.UseAtlas {
+		atlasPath := opts.atlasPath()
+		if err := atlas.FromMap(opts.ClanId, maxTurnId, worldMap, r.LastSeen).Save(atlasPath); err != nil {
+			return r, fmt.Errorf("atlas: %s: %w", atlasPath, err)
+		}
+		r.AtlasPath = atlasPath
+		log.Printf("atlas: %s: saved %d tiles through turn %s\n", atlasPath, worldMap.Length(), maxTurnId)
+	}
+
+	// dangerous but try to find the origin hex if asked
+	mapper := opts.Mapper
+	if opts.ShowOrigin {
+		for _, turn := range consolidatedTurns {
+			for _, unit := range turn.SortedMoves {
+				mapper.Origin, _ = coords.HexToMap(unit.FromHex)
+				break
+			}
+			break
+		}
+		log.Printf("info: origin hex set to %q\n", mapper.Origin)
+	}
+
+	// dangerous, shift the map
+	mapper.Render.ShiftMap = opts.ShiftMap
+	if mapper.Render.ShiftMap {
+		log.Printf("warn: will shift map up and left\n")
+	}
+
+	if opts.Debug.DumpAllTurns {
+		if err := dumpTurns(consolidatedTurns); err != nil {
+			return r, err
+		}
+	}
+
+	if opts.Debug.DumpAllTiles {
+		worldMap.Dump()
+	}
+
+	// map the data
+	hexMap, err := actions.MapWorld(worldMap, opts.clans(), mapper)
+	if err != nil {
+		return r, err
+	}
+	hexMap.TurnId = maxTurnId
+	hexMap.Trails = actions.MapTrails(consolidatedTurns, opts.Trails)
+	log.Printf("map: %8d nodes: elapsed %v\n", worldMap.Length(), time.Since(started))
+
+	// now we can create the map!
+	mapName := opts.mapPath(maxTurnId, opts.ClanId, "", renderer.Extension())
+	if err := renderer.Render(mapName, hexMap); err != nil {
+		return r, fmt.Errorf("%s: %w", mapName, err)
+	}
+	r.MapPath = mapName
+	log.Printf("created  %s\n", mapName)
+
+	// list the items found so that they can be searched without opening the map
+	itemsName := filepath.Join(opts.OutputPath, fmt.Sprintf("%s.items.txt", opts.ClanId))
+	var items bytes.Buffer
+	if n, err := worldMap.DumpItems(&items); err != nil {
+		return r, fmt.Errorf("items: %w", err)
+	} else if n != 0 {
+		if err := os.WriteFile(itemsName, items.Bytes(), 0644); err != nil {
+			return r, fmt.Errorf("items: %s: %w", itemsName, err)
+		}
+		r.ItemsPath = itemsName
+		log.Printf("created  %s: %d items\n", itemsName, n)
+	} else if err := os.Remove(itemsName); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		// the file from an earlier run would list items that are no longer on the map
+		return r, fmt.Errorf("items: %s: %w", itemsName, err)
+	}
+
+	if opts.PerClan {
+		r.ClanPaths = map[string]string{}
+		for _, clan := range opts.clans() {
+			clanMapName, err := renderClan(opts, renderer, mapper, clan, consolidatedTurns, maxTurnId)
+			if err != nil {
+				return r, err
+			} else if clanMapName != "" {
+				r.ClanPaths[string(clan)] = clanMapName
+			}
+		}
+	}
+
+	r.saveDiagnostics(opts)
+
+	log.Printf("elapsed: %v\n", time.Since(started))
+
+	return r, nil
+}
+
+// Walk parses the turn reports in the input folder and walks the moves to
+// build the world map. It doesn't create the map or save the atlas, so
+// commands that only need the results of the walk can use it.
+//
+// It returns ErrDiagnostics if the reports have errors, along with the
+// result so that the caller can show them.
+func Walk(opts Options) (*Result, error) {
+	maxYear, maxMonth, err := opts.maxTurn()
+	if err != nil {
+		return nil, err
+	}
+	maxTurnCutoff := fmt.Sprintf("%04d-%02d", maxYear, maxMonth)
+
+	// dx collects the problems found in all the reports so that we can report them at once
+	r := &Result{Diagnostics: diagnostics.New()}
+	dx := r.Diagnostics
+
+	// load the atlas if asked. it holds the world map from the last run,
+	// so we only need to walk the turns that are newer than the atlas.
+	atlasPath := opts.atlasPath()
+	var worldAtlas *atlas.Atlas_t
+	if opts.UseAtlas {
+		if a, err := atlas.Load(atlasPath); err != nil {
+			if !os.IsNotExist(err) {
+				return nil, fmt.Errorf("atlas: %s: %w", atlasPath, err)
+			}
+			log.Printf("atlas: %s: not found, walking all turns\n", atlasPath)
+		} else if a.ClanId != opts.ClanId {
+			return nil, fmt.Errorf("atlas: %s: clan %q: expected clan %q", atlasPath, a.ClanId, opts.ClanId)
+		} else if a.TurnId > maxTurnCutoff {
+			log.Printf("atlas: %s: turn %s is past cutoff %s, walking all turns\n", atlasPath, a.TurnId, maxTurnCutoff)
+		} else {
+			worldAtlas = a
+			log.Printf("atlas: %s: loaded %d tiles through turn %s\n", atlasPath, len(a.Tiles), a.TurnId)
+		}
+	}
+
+	inputs, err := turns.CollectInputs(opts.InputPath, maxYear, maxMonth)
+	if err != nil {
+		return nil, fmt.Errorf("inputs: %w", err)
+	}
+	log.Printf("inputs: found %d turn reports\n", len(inputs))
+	r.reportFiles = map[string]string{}
+	for _, i := range inputs {
+		r.reportFiles[i.Id] = filepath.Base(i.Path)
+	}
+
+	var maxTurnId string // will be set to the last/maximum turnId we process
+	if worldAtlas != nil {
+		maxTurnId = worldAtlas.TurnId
+	}
+	allTurns, lastTurnId, err := parseInputs(opts, inputs, worldAtlas, dx)
+	if err != nil {
+		return nil, err
+	} else if lastTurnId > maxTurnId {
+		maxTurnId = lastTurnId
+	}
+	r.TurnId = maxTurnId
+
+	consolidatedTurns := consolidate(allTurns, dx)
+	r.Turns = consolidatedTurns
+
+	// check for gaps between the turns
+	if missing := turns.CheckCalendar(consolidatedTurns, dx); len(missing) != 0 {
+		log.Printf("calendar: missing %d turns\n", len(missing))
+	}
+
+	checkLinks(consolidatedTurns, dx)
+
+	// stop if we found any problems in the reports
+	if dx.Errors() != 0 {
+		return r, ErrDiagnostics
+	}
+
+	patchObscuredLinks(consolidatedTurns)
+
+	// replace the "##" in obscured locations with the true grid
+	turns.InferGrids(consolidatedTurns, opts.OriginGrid, dx)
+	if dx.Errors() != 0 {
+		return r, ErrDiagnostics
+	}
+
+	// walk the data, starting from the atlas if we have one
+	var worldMap *tiles.Map_t
+	var lastSeen map[parser.UnitId_t]coords.Map
+	if worldAtlas != nil {
+		if worldMap, lastSeen, err = worldAtlas.ToMap(); err != nil {
+			return r, fmt.Errorf("atlas: %s: %w", atlasPath, err)
+		}
+	} else {
+		lastSeen = map[parser.UnitId_t]coords.Map{}
+	}
+	worldMap, err = turns.Walk(consolidatedTurns, worldMap, lastSeen, opts.OriginGrid, opts.QuitOnInvalidGrid, opts.WarnOnInvalidGrid, opts.Debug.Maps, dx)
+	if err != nil {
+		return r, ErrDiagnostics
+	}
+	r.WorldMap, r.LastSeen = worldMap, lastSeen
+
+	return r, nil
+}
+
+// saveDiagnostics writes the problems to a JSON file for other tools to use
+func (r *Result) saveDiagnostics(opts Options) {
+	if !opts.ErrorsJson {
+		return
+	}
+	for _, d := range r.Diagnostics.Diagnostics {
+		if d.File == "" {
+			d.File = r.reportFiles[d.ReportId]
+		}
+	}
+	errorsPath := filepath.Join(opts.OutputPath, fmt.Sprintf("%s.errors.json", opts.ClanId))
+	if err := r.Diagnostics.Save(errorsPath); err != nil {
+		log.Printf("error: %s: %v\n", errorsPath, err)
+		return
+	}
+	r.ErrorsPath = errorsPath
+	log.Printf("created  %s\n", errorsPath)
+}
+
+// parseInputs parses the turn reports, using the cache if asked.
+// Reports that were already walked into the atlas are skipped.
+// It returns the turns grouped by turn id along with the last turn id parsed.
+func parseInputs(opts Options, inputs []*turns.TurnReportFile_t, worldAtlas *atlas.Atlas_t, dx *diagnostics.Collector_t) (map[string][]*parser.Turn_t, string, error) {
+	started := time.Now()
+	maxYear, maxMonth, err := opts.maxTurn()
+	if err != nil {
+		return nil, "", err
+	}
+
+	// load the parse cache if asked. reports that haven't changed since the last run won't be parsed again.
+	cachePath := filepath.Join(opts.OutputPath, "parse.cache.json")
+	parseCache := cache.New()
+	if opts.Incremental {
+		parseCache = cache.Load(cachePath)
+		log.Printf("cache: %s: loaded %d entries\n", cachePath, len(parseCache.Entries))
+	}
+	// parseOptions records the options that change the results from the parser
+	parseOptions := fmt.Sprintf("split-units=%v ignore-scouts=%v strip-cr=%v", opts.Experimental.SplitTrailingUnits, opts.Parser.Ignore.Scouts, opts.Experimental.StripCR)
+	cacheHits := 0
+
+	// allTurns holds the turn and move data and allows multiple clans to be loaded.
+	allTurns := map[string][]*parser.Turn_t{}
+	totalUnitMoves := 0
+	var maxTurnId string
+	for _, i := range inputs {
+		started := time.Now()
+		data, err := os.ReadFile(i.Path)
+		if err != nil {
+			return nil, "", fmt.Errorf("read: %w", err)
+		}
+		hash := cache.Hash(data)
+		if opts.Experimental.StripCR {
+			data = bytes.ReplaceAll(data, []byte{'\r', '\n'}, []byte{'\n'})
+		}
+		if i.Turn.Year < 899 || i.Turn.Year > 9999 || i.Turn.Month < 1 || i.Turn.Month > 12 {
+			log.Printf("warn: %q: invalid turn year '%d'\n", i.Id, i.Turn.Year)
+			continue
+		} else if i.Turn.Month < 1 || i.Turn.Month > 12 {
+			log.Printf("warn: %q: invalid turn month '%d'\n", i.Id, i.Turn.Month)
+			continue
+		}
+		pastCutoff := false
+		if i.Turn.Year > maxYear {
+			pastCutoff = true
+		} else if i.Turn.Year == maxYear {
+			if i.Turn.Month > maxMonth {
+				pastCutoff = true
+			}
+		}
+		if pastCutoff {
+			log.Printf("warn: %q: past cutoff %04d-%02d\n", i.Id, maxYear, maxMonth)
+		}
+		if worldAtlas != nil && fmt.Sprintf("%04d-%02d", i.Turn.Year, i.Turn.Month) <= worldAtlas.TurnId {
+			// already walked into the atlas
+			continue
+		}
+		turnId := fmt.Sprintf("%04d-%02d", i.Turn.Year, i.Turn.Month)
+		if turnId > maxTurnId {
+			maxTurnId = turnId
+		}
+		turn, cached, ok := parseCache.Lookup(i.Path, hash, parseOptions)
+		if ok {
+			// replay the warnings so that they are reported on every run
+			cacheHits++
+			for _, d := range cached {
+				dx.Add(d)
+			}
+		} else {
+			n := len(dx.Diagnostics)
+			if turn, err = parser.ParseInput(i.Id, turnId, data, opts.Debug.Parser, opts.Debug.Sections, opts.Debug.Steps, opts.Debug.Nodes, opts.Experimental.SplitTrailingUnits, opts.Parser, dx); err != nil {
+				// the problems are in the diagnostics. keep the partial turn so that we can check the links, too.
+				log.Printf("%q: %v\n", i.Id, err)
+			} else {
+				parseCache.Store(i.Path, hash, parseOptions, turn, slices.Clone(dx.Diagnostics[n:]))
+			}
+		}
+		if turnId != fmt.Sprintf("%04d-%02d", turn.Year, turn.Month) {
+			if turn.Year == 0 { // the turn line didn't parse, and that has been reported
+				continue
+			}
+			dx.Add(&diagnostics.Diagnostic_t{
+				Severity: diagnostics.Error,
+				ReportId: i.Id,
+				TurnId:   turnId,
+				Message:  fmt.Sprintf("expected turn %q: got turn %q", turnId, fmt.Sprintf("%04d-%02d", turn.Year, turn.Month)),
+				Fix:      "rename the report file to match the turn in the report",
+			})
+			continue
+		}
+		allTurns[turnId] = append(allTurns[turnId], turn)
+		totalUnitMoves += len(turn.UnitMoves)
+		log.Printf("%q: parsed %6d units in %v\n", i.Id, len(turn.UnitMoves), time.Since(started))
+	}
+	log.Printf("parsed %d inputs in to %d turns and %d units %v\n", len(inputs), len(allTurns), totalUnitMoves, time.Since(started))
+
+	// save the cache now, before the walk updates the turns
+	if opts.Incremental {
+		parseCache.Prune()
+		if err := parseCache.Save(cachePath); err != nil {
+			return nil, "", fmt.Errorf("cache: %s: %w", cachePath, err)
+		}
+		log.Printf("cache: %s: reused %d of %d reports\n", cachePath, cacheHits, len(inputs))
+	}
+
+	return allTurns, maxTurnId, nil
+}
+
+// consolidate merges the reports for each turn into a single turn.
+// The turns are sorted by year and month and linked to the turns before and after them.
+func consolidate(allTurns map[string][]*parser.Turn_t, dx *diagnostics.Collector_t) []*parser.Turn_t {
+	var consolidatedTurns []*parser.Turn_t
+	for _, unitTurns := range allTurns {
+		if len(unitTurns) == 0 {
+			// we shouldn't have any empty turns, but be safe
+			continue
+		}
+		// create a new turn to hold the consolidated unit moves for the turn
+		turn := &parser.Turn_t{
+			Id:         fmt.Sprintf("%04d-%02d", unitTurns[0].Year, unitTurns[0].Month),
+			Year:       unitTurns[0].Year,
+			Month:      unitTurns[0].Month,
+			Season:     unitTurns[0].Season,
+			Weather:    unitTurns[0].Weather,
+			NextTurnId: unitTurns[0].NextTurnId,
+			ReportDate: unitTurns[0].ReportDate,
+			UnitMoves:  map[parser.UnitId_t]*parser.Moves_t{},
+		}
+		consolidatedTurns = append(consolidatedTurns, turn)
+
+		// copy all the unit moves into this new turn, calling out duplicates
+		for _, unitTurn := range unitTurns {
+			for id, unitMoves := range unitTurn.UnitMoves {
+				if turn.UnitMoves[id] != nil && sameMoves(turn.UnitMoves[id], unitMoves) {
+					// allies may share copies of the same report. keep the first copy.
+					dx.Add(&diagnostics.Diagnostic_t{
+						Severity: diagnostics.Warning,
+						ReportId: unitMoves.ReportId,
+						TurnId:   turn.Id,
+						UnitId:   string(id),
+						Message:  fmt.Sprintf("duplicate unit: same as %q: ignored", turn.UnitMoves[id].ReportId),
+					})
+					continue
+				} else if turn.UnitMoves[id] != nil {
+					dx.Add(&diagnostics.Diagnostic_t{
+						Severity: diagnostics.Error,
+						ReportId: unitMoves.ReportId,
+						TurnId:   turn.Id,
+						UnitId:   string(id),
+						Message:  fmt.Sprintf("duplicate unit: also found in %q", turn.UnitMoves[id].ReportId),
+						Fix:      "remove the unit from one of the reports",
+					})
+				}
+				turn.UnitMoves[id] = unitMoves
+				turn.SortedMoves = append(turn.SortedMoves, unitMoves)
+			}
+		}
+	}
+	sort.Slice(consolidatedTurns, func(i, j int) bool {
+		a, b := consolidatedTurns[i], consolidatedTurns[j]
+		if a.Year < b.Year {
+			return true
+		} else if a.Year == b.Year {
+			return a.Month < b.Month
+		}
+		return false
+	})
+	for _, turn := range consolidatedTurns {
+		log.Printf("%s: %8d units\n", turn.Id, len(turn.UnitMoves))
+		sort.Slice(turn.SortedMoves, func(i, j int) bool {
+			return turn.SortedMoves[i].Id < turn.SortedMoves[j].Id
+		})
+	}
+
+	// link prev and next turns
+	for n, turn := range consolidatedTurns {
+		if n > 0 {
+			turn.Prev = consolidatedTurns[n-1]
+		}
+		if n+1 < len(consolidatedTurns) {
+			turn.Next = consolidatedTurns[n+1]
+		}
+	}
+
+	return consolidatedTurns
+}
+
+// sameMoves returns true if the two sections for a unit have the same movement.
+func sameMoves(a, b *parser.Moves_t) bool {
+	if a.FromHex != b.FromHex || a.ToHex != b.ToHex || a.Follows != b.Follows || a.GoesTo != b.GoesTo {
+		return false
+	} else if len(a.Moves) != len(b.Moves) || len(a.Scouts) != len(b.Scouts) {
+		return false
+	}
+	for n := range a.Moves {
+		if !bytes.Equal(a.Moves[n].Line, b.Moves[n].Line) {
+			return false
+		}
+	}
+	for n := range a.Scouts {
+		if !bytes.Equal(a.Scouts[n].Line, b.Scouts[n].Line) {
+			return false
+		}
+	}
+	return true
+}
+
+// renderClan walks the moves from a single clan's reports and writes the map
+// for that clan. It is called after the combined map has been created.
+// Problems walking the moves are logged as warnings since they were already
+// checked in the combined walk; they are usually moves that follow or go to
+// an allied unit.
+func renderClan(opts Options, renderer actions.Renderer, mapper actions.MapConfig, clan parser.UnitId_t, consolidatedTurns []*parser.Turn_t, maxTurnId string) (string, error) {
+	var clanTurns []*parser.Turn_t
+	for _, turn := range consolidatedTurns {
+		clanTurn := &parser.Turn_t{
+			Id:        turn.Id,
+			Year:      turn.Year,
+			Month:     turn.Month,
+			UnitMoves: map[parser.UnitId_t]*parser.Moves_t{},
+		}
+		for _, unitMoves := range turn.SortedMoves {
+			if _, reportClan, _ := strings.Cut(unitMoves.ReportId, "."); reportClan != string(clan) {
+				continue
+			}
+			// copy the moves so that the walk starts from the unit's previous hex
+			clanMoves := *unitMoves
+			clanMoves.Location = coords.Map{}
+			clanTurn.UnitMoves[clanMoves.Id] = &clanMoves
+			clanTurn.SortedMoves = append(clanTurn.SortedMoves, &clanMoves)
+		}
+		if len(clanTurn.SortedMoves) != 0 {
+			clanTurns = append(clanTurns, clanTurn)
+		}
+	}
+	if len(clanTurns) == 0 {
+		log.Printf("warn: clan %s: no reports found\n", clan)
+		return "", nil
+	}
+
+	dx := diagnostics.New()
+	worldMap, err := turns.Walk(clanTurns, nil, nil, opts.OriginGrid, opts.QuitOnInvalidGrid, opts.WarnOnInvalidGrid, opts.Debug.Maps, dx)
+	if err != nil {
+		log.Printf("warn: clan %s: %v: the clan map may be missing some moves\n", clan, err)
+	}
+	hexMap, err := actions.MapWorld(worldMap, opts.clans(), mapper)
+	if err != nil {
+		return "", err
+	}
+	hexMap.TurnId = maxTurnId
+	hexMap.Trails = actions.MapTrails(clanTurns, opts.Trails)
+	mapName := opts.mapPath(maxTurnId, string(clan), ".own", renderer.Extension())
+	if err := renderer.Render(mapName, hexMap); err != nil {
+		return "", fmt.Errorf("%s: %w", mapName, err)
+	}
+	log.Printf("created  %s\n", mapName)
+	return mapName, nil
+}
+
+// checkLinks makes sure that each unit's previous hex matches the current hex
+// from the turn before. Problems are added to the diagnostics.
+// Units created this turn may have a previous hex of "N/A"; the walk places them.
+func checkLinks(consolidatedTurns []*parser.Turn_t, dx *diagnostics.Collector_t) {
+	// sanity check on the current and prior locations.
+	badLinks, goodLinks := 0, 0
+	for _, turn := range consolidatedTurns {
+		if turn.Next == nil { // nothing to update
+			continue
+		}
+		for _, unitMoves := range turn.UnitMoves {
+			nextUnitMoves := turn.Next.UnitMoves[unitMoves.Id]
+			if nextUnitMoves == nil {
+				continue
+			}
+			if unitMoves.ToHex[2:] != nextUnitMoves.FromHex[2:] {
+				badLinks++
+				dx.Add(&diagnostics.Diagnostic_t{
+					Severity: diagnostics.Error,
+					ReportId: nextUnitMoves.ReportId,
+					TurnId:   nextUnitMoves.TurnId,
+					UnitId:   string(nextUnitMoves.Id),
+					Message:  fmt.Sprintf("previous hex %q does not match current hex %q from turn %s", nextUnitMoves.FromHex, unitMoves.ToHex, turn.Id),
+					Fix:      "the previous and current hexes should always align; please report this error",
+				})
+			} else {
+				goodLinks++
+			}
+			nextUnitMoves.FromHex = unitMoves.ToHex
+		}
+	}
+	log.Printf("links: %d good, %d bad\n", goodLinks, badLinks)
+}
+
+// patchObscuredLinks proactively patches some of the obscured locations.
+// Turn reports initially gave obscured locations for from and to hexes.
+// Around 0902-02, the current location stopped being obscured,
+// but the previous location is still obscured.
+//
+// NB: links between the locations must be validated before patching them!
+func patchObscuredLinks(consolidatedTurns []*parser.Turn_t) {
+	updatedCurrentLinks, updatedPreviousLinks := 0, 0
+	for _, turn := range consolidatedTurns {
+		for _, unitMoves := range turn.UnitMoves {
+			var prevTurnMoves *parser.Moves_t
+			if turn.Prev != nil {
+				prevTurnMoves = turn.Prev.UnitMoves[unitMoves.Id]
+			}
+			var nextTurnMoves *parser.Moves_t
+			if turn.Next != nil {
+				nextTurnMoves = turn.Next.UnitMoves[unitMoves.Id]
+			}
+
+			// link prior.ToHex and this.FromHex if this.FromHex is not obscured
+			if !strings.HasPrefix(unitMoves.FromHex, "##") && prevTurnMoves != nil {
+				if prevTurnMoves.ToHex != unitMoves.FromHex {
+					updatedPreviousLinks++
+					prevTurnMoves.ToHex = unitMoves.FromHex
+				}
+			}
+
+			// link this.ToHex and next.FromHex if this.ToHex is not obscured
+			if !strings.HasPrefix(unitMoves.ToHex, "##") && nextTurnMoves != nil {
+				if unitMoves.ToHex != nextTurnMoves.FromHex {
+					updatedCurrentLinks++
+					nextTurnMoves.FromHex = unitMoves.ToHex
+				}
+			}
+		}
+	}
+	log.Printf("updated %8d obscured 'Previous Hex' locations\n", updatedPreviousLinks)
+	log.Printf("updated %8d obscured 'Current Hex'  locations\n", updatedCurrentLinks)
+}
+
+// dumpTurns logs the results of every move in every turn.
+// It returns an error if a move is missing its report or terrain.
+func dumpTurns(consolidatedTurns []*parser.Turn_t) error {
+	log.Printf("hey, dumping it all\n")
+	for _, turn := range consolidatedTurns {
+		log.Printf("%s: sortedMoves %d\n", turn.Id, len(turn.SortedMoves))
+		for _, unit := range turn.SortedMoves {
+			for _, move := range unit.Moves {
+				if move.Report == nil {
+					return fmt.Errorf("%s: %s: %d: %d: %s: missing report", move.TurnId, unit.Id, move.LineNo, move.StepNo, move.CurrentHex)
+				} else if move.Report.Terrain == terrain.Blank {
+					if move.Result == results.Failed {
+						log.Printf("%s: %-6s: %s: failed\n", move.TurnId, unit.Id, move.CurrentHex)
+					} else if move.Still {
+						log.Printf("%s: %-6s: %s: stayed in place\n", move.TurnId, unit.Id, move.CurrentHex)
+					} else if move.Follows != "" {
+						log.Printf("%s: %-6s: %s: follows %s\n", move.TurnId, unit.Id, move.CurrentHex, move.Follows)
+					} else if move.GoesTo != "" {
+						log.Printf("%s: %-6s: %s: goes to %s\n", move.TurnId, unit.Id, move.CurrentHex, move.GoesTo)
+					} else {
+						return fmt.Errorf("%s: %s: %d: %d: %s: missing terrain", move.TurnId, unit.Id, move.LineNo, move.StepNo, move.CurrentHex)
+					}
+				} else {
+					log.Printf("%s: %-6s: %s: terrain %s\n", move.TurnId, unit.Id, move.CurrentHex, move.Report.Terrain)
+				}
+				for _, border := range move.Report.Borders {
+					if border.Edge != edges.None {
+						log.Printf("%s: %-6s: %s: border  %-14s %q\n", move.TurnId, unit.Id, move.CurrentHex, border.Direction, border.Edge)
+					}
+					if border.Terrain != terrain.Blank {
+						log.Printf("%s: %-6s: %s: border  %-14s %q\n", move.TurnId, unit.Id, move.CurrentHex, border.Direction, border.Terrain)
+					}
+				}
+				for _, point := range move.Report.FarHorizons {
+					log.Printf("%s: %-6s: %s: compass %-14s sighted %q\n", move.TurnId, unit.Id, move.CurrentHex, point.Point, point.Terrain)
+				}
+				for _, settlement := range move.Report.Settlements {
+					log.Printf("%s: %-6s: %s: village %q\n", move.TurnId, unit.Id, move.CurrentHex, settlement.Name)
+				}
+				for _, lh := range move.Report.Longhouses {
+					log.Printf("%s: %-6s: %s: longhouse %s %d\n", move.TurnId, unit.Id, move.CurrentHex, lh.Id, lh.Capacity)
+				}
+				for _, obstacle := range move.Report.Obstacles {
+					log.Printf("%s: %-6s: %s: obstacle %s\n", move.TurnId, unit.Id, move.CurrentHex, obstacle)
+				}
+				for _, patrol := range move.Report.Patrols {
+					log.Printf("%s: %-6s: %s: patrol  %s\n", move.TurnId, unit.Id, move.CurrentHex, patrol)
+				}
+				if move.Report.Wind != nil {
+					log.Printf("%s: %-6s: %s: wind    %s %s\n", move.TurnId, unit.Id, move.CurrentHex, move.Report.Wind.Strength, move.Report.Wind.From)
+				}
+				for _, item := range move.Report.Items {
+					log.Printf("%s: %-6s: %s: found   %d %s\n", move.TurnId, unit.Id, move.CurrentHex, item.Quantity, item.Item)
+				}
+			}
+		}
+	}
+	return nil
+}
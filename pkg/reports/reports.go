@@ -0,0 +1,385 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+// Package reports parses TribeNet turn reports.
+//
+// This package is supported for use by other programs. The types in this
+// package are the stable view of the parser's results: enums are returned
+// as the strings used in the turn reports (for example, "PR" for prairie or
+// "NE" for north-east) and locations are returned as grid coordinates
+// ("AB 0101"). New fields may be added, but existing fields will not be
+// removed or change meaning without a change to the major version.
+//
+// The packages under internal/ are not supported for use by other programs
+// and may change at any time.
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/mdhender/ottomap/internal/diagnostics"
+	"github.com/mdhender/ottomap/internal/parser"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	rxCurrentTurn = regexp.MustCompile(`^Current Turn (\d{3,4})-(\d{1,2}) `)
+)
+
+// Options changes how the report is parsed.
+// The zero value is the same as the render command's defaults.
+type Options struct {
+	// ReportId identifies the report in diagnostics, for example "0900-01.0991".
+	ReportId string
+	// TurnId is the turn of the report, for example "0900-01".
+	// If it is empty, it is taken from the first "Current Turn" line in the report.
+	TurnId string
+
+	IgnoreScouts       bool // ignore the scout lines in the report
+	StripCR            bool // convert DOS line endings before parsing
+	SplitTrailingUnits bool // experimental: split unit ids from the end of the line
+
+	// Debug options write to the standard logger
+	Debug struct {
+		Parser   bool
+		Sections bool
+		Steps    bool
+		Nodes    bool
+	}
+}
+
+// Report is the result of parsing a turn report.
+type Report struct {
+	Id         string // report id from the options
+	TurnId     string // for example, "0900-01"
+	Year       int
+	Month      int
+	Season     string  // for example, "Winter"
+	Weather    string  // for example, "FINE"
+	NextTurnId string  // turn id of the next turn, if reported
+	ReportDate string  // date printed after the next turn, as yyyy-mm-dd
+	Units      []*Unit // sorted by unit id
+
+	// Settlements and TransferLog are the tables printed at the end of the report.
+	Settlements []*SettlementEntry
+	TransferLog []*TransferEntry
+
+	// Diagnostics holds every problem found, including warnings.
+	Diagnostics []*Diagnostic
+}
+
+// Unit is the movement of a single unit in the report,
+// along with the rest of its section.
+type Unit struct {
+	Id          string
+	PreviousHex string // may be obscured ("## 0101") or "N/A" for new units
+	CurrentHex  string // may be obscured ("## 0101")
+	Follows     string // id of the unit followed, if any
+	GoesTo      string // hex the unit was sent to, if any
+	Moves       []*Move
+	Scouts      []*Scout
+	Section     *Section // nil if the section header couldn't be parsed
+}
+
+// Scout is the movement of a scouting party sent out by a unit.
+type Scout struct {
+	No    int // scout number, from 1 to 8
+	Moves []*Move
+}
+
+// Move is a single step of a unit's movement, along with the
+// observations made in the hex the unit ended the step in.
+type Move struct {
+	LineNo    int    // line number in the report, indexed from 1
+	StepNo    int    // step number in the line, indexed from 1
+	Direction string // direction the unit moved, empty if it didn't advance
+	Follows   string // id of the unit followed
+	GoesTo    string // hex the unit was sent to
+	Still     bool   // true if the unit stayed in place
+	Result    string // for example, "Succeeded" or "Blocked"
+	Text      string // text of the step from the report
+
+	Terrain     string // terrain code, for example "PR"
+	Borders     []*Border
+	Encounters  []string // ids of units in the hex
+	Items       []*Item  // items found by scouts
+	Longhouses  []*Longhouse
+	Obstacles   []*Obstacle
+	Patrols     []*Patrol
+	Resources   []string
+	Settlements []string // names of the settlements; the report doesn't give more than the name
+	Wind        *Wind    // nil unless the unit is a fleet
+	FarHorizons []*FarHorizon
+}
+
+// Border is an observation of one side of the hex.
+type Border struct {
+	Direction string // for example, "NE"
+	Edge      string // edge feature, for example "River"; empty if none
+	Terrain   string // terrain code of the neighbor, if it could be seen
+}
+
+// Item is a quantity of goods found in a hex.
+type Item struct {
+	Quantity int
+	Item     string
+}
+
+// Longhouse is a longhouse seen in the hex.
+type Longhouse struct {
+	Id       string
+	Capacity int
+}
+
+// Obstacle is a border that stopped the unit from leaving the hex.
+type Obstacle struct {
+	Direction string // for example, "NE"
+	Result    string // "Blocked", "Prohibited", or "Exhausted MPs"
+	Edge      string // edge that blocked the unit, for example "River"; empty if none
+	Terrain   string // terrain the unit couldn't enter, for example "O"; empty if none
+	Scouts    bool   // true if the unit was a scouting party
+}
+
+// Patrol is the outcome of a scouting party's patrol of the hex.
+type Patrol struct {
+	ScoutNo int      // zero if the outcome was not reported on a scout line
+	Lost    bool     // true if the scouts did not return
+	Found   []string // ids of the units found
+}
+
+// Wind is the wind reported by a fleet.
+type Wind struct {
+	Strength string // for example, "MILD"
+	From     string // for example, "NE"
+}
+
+// FarHorizon is land or water sighted from a fleet's crow's nest.
+type FarHorizon struct {
+	Point   string // for example, "NorthNorthEast"
+	Terrain string // "UL" for land or "UW" for water
+}
+
+// Diagnostic is a problem found in the report.
+// Line and Column are indexed from 1. They are zero when they don't apply.
+type Diagnostic struct {
+	Severity string // "error", "warning", or "info"
+	UnitId   string
+	Line     int
+	Column   int
+	Message  string
+	Expected []string // tokens the parser expected at the column
+	Text     string   // the text that caused the problem
+	Fix      string   // suggested fix
+}
+
+func (d *Diagnostic) String() string {
+	if d.Line == 0 {
+		return fmt.Sprintf("%s: %s", d.Severity, d.Message)
+	} else if d.Column == 0 {
+		return fmt.Sprintf("%s: line %d: %s", d.Severity, d.Line, d.Message)
+	}
+	return fmt.Sprintf("%s: line %d col %d: %s", d.Severity, d.Line, d.Column, d.Message)
+}
+
+// Error is returned by Parse when the report has errors.
+// The report is still returned so that the caller can use the parts that parsed.
+type Error struct {
+	ReportId    string
+	Diagnostics []*Diagnostic // only the errors; warnings are in the report
+}
+
+func (e *Error) Error() string {
+	if len(e.Diagnostics) == 1 {
+		return fmt.Sprintf("%s: %s", e.ReportId, e.Diagnostics[0])
+	}
+	return fmt.Sprintf("%s: %d errors", e.ReportId, len(e.Diagnostics))
+}
+
+// Parse reads and parses a turn report.
+//
+// It returns an *Error if the report has errors. In that case the report
+// is returned, too, holding the units that could be parsed. Any other
+// error is from reading the input.
+func Parse(r io.Reader, opts Options) (*Report, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if opts.StripCR {
+		data = bytes.ReplaceAll(data, []byte{'\r', '\n'}, []byte{'\n'})
+	}
+	turnId := opts.TurnId
+	if turnId == "" {
+		turnId = findTurnId(data)
+	}
+
+	var cfg parser.ParseConfig
+	cfg.Ignore.Scouts = opts.IgnoreScouts
+	dx := diagnostics.New()
+	turn := parseInput(opts, turnId, data, cfg, dx)
+
+	rpt := &Report{Id: opts.ReportId}
+	if turn != nil {
+		rpt.TurnId, rpt.Year, rpt.Month = turn.Id, turn.Year, turn.Month
+		rpt.Season, rpt.Weather = turn.Season, turn.Weather
+		rpt.NextTurnId, rpt.ReportDate = turn.NextTurnId, turn.ReportDate
+		for _, moves := range turn.UnitMoves {
+			u := newUnit(moves)
+			if turn.Sections != nil && turn.Sections.Units[moves.Id] != nil {
+				u.Section = newSection(turn.Sections.Units[moves.Id])
+			}
+			rpt.Units = append(rpt.Units, u)
+		}
+		if turn.Sections != nil {
+			for _, entry := range turn.Sections.Settlements {
+				rpt.Settlements = append(rpt.Settlements, newSettlementEntry(entry))
+			}
+			for _, entry := range turn.Sections.TransferLog {
+				rpt.TransferLog = append(rpt.TransferLog, newTransferEntry(entry))
+			}
+		}
+		sort.Slice(rpt.Units, func(i, j int) bool {
+			return rpt.Units[i].Id < rpt.Units[j].Id
+		})
+	}
+
+	dx.Sort()
+	var errs []*Diagnostic
+	for _, d := range dx.Diagnostics {
+		diagnostic := &Diagnostic{
+			Severity: d.Severity.String(),
+			UnitId:   d.UnitId,
+			Line:     d.Line,
+			Column:   d.Column,
+			Message:  d.Message,
+			Expected: d.Expected,
+			Text:     d.Text,
+			Fix:      d.Fix,
+		}
+		rpt.Diagnostics = append(rpt.Diagnostics, diagnostic)
+		if d.Severity == diagnostics.Error {
+			errs = append(errs, diagnostic)
+		}
+	}
+	if len(errs) != 0 {
+		return rpt, &Error{ReportId: opts.ReportId, Diagnostics: errs}
+	}
+
+	return rpt, nil
+}
+
+// parseInput calls the parser. If the parser panics, the panic is
+// added to the diagnostics as an error and the turn is nil.
+func parseInput(opts Options, turnId string, data []byte, cfg parser.ParseConfig, dx *diagnostics.Collector_t) (turn *parser.Turn_t) {
+	defer func() {
+		if r := recover(); r != nil {
+			turn = nil
+			dx.Add(&diagnostics.Diagnostic_t{
+				Severity: diagnostics.Error,
+				ReportId: opts.ReportId,
+				TurnId:   turnId,
+				Message:  fmt.Sprintf("parser: %v", r),
+				Fix:      "please report this error",
+			})
+		}
+	}()
+	turn, _ = parser.ParseInput(opts.ReportId, turnId, data, opts.Debug.Parser, opts.Debug.Sections, opts.Debug.Steps, opts.Debug.Nodes, opts.SplitTrailingUnits, cfg, dx)
+	return turn
+}
+
+// findTurnId returns the turn id from the first "Current Turn" line in the report.
+// It returns an empty string if there isn't one.
+func findTurnId(data []byte) string {
+	for _, line := range bytes.Split(data, []byte{'\n'}) {
+		if match := rxCurrentTurn.FindSubmatch(line); match != nil {
+			return fmt.Sprintf("%04s-%02s", match[1], match[2])
+		}
+	}
+	return ""
+}
+
+func newUnit(moves *parser.Moves_t) *Unit {
+	u := &Unit{
+		Id:          string(moves.Id),
+		PreviousHex: moves.FromHex,
+		CurrentHex:  moves.ToHex,
+		Follows:     string(moves.Follows),
+		GoesTo:      moves.GoesTo,
+	}
+	for _, move := range moves.Moves {
+		u.Moves = append(u.Moves, newMove(move))
+	}
+	for _, scout := range moves.Scouts {
+		s := &Scout{No: scout.No}
+		for _, move := range scout.Moves {
+			s.Moves = append(s.Moves, newMove(move))
+		}
+		u.Scouts = append(u.Scouts, s)
+	}
+	return u
+}
+
+func newMove(move *parser.Move_t) *Move {
+	m := &Move{
+		LineNo:  move.LineNo,
+		StepNo:  move.StepNo,
+		Follows: string(move.Follows),
+		GoesTo:  move.GoesTo,
+		Still:   move.Still,
+		Result:  move.Result.String(),
+		Text:    strings.TrimSpace(string(move.Line)),
+	}
+	if move.Advance != 0 {
+		m.Direction = move.Advance.String()
+	}
+	if report := move.Report; report != nil {
+		if report.Terrain != 0 {
+			m.Terrain = report.Terrain.String()
+		}
+		for _, border := range report.Borders {
+			b := &Border{Direction: border.Direction.String(), Edge: border.Edge.String()}
+			if border.Terrain != 0 {
+				b.Terrain = border.Terrain.String()
+			}
+			m.Borders = append(m.Borders, b)
+		}
+		for _, encounter := range report.Encounters {
+			m.Encounters = append(m.Encounters, string(encounter.UnitId))
+		}
+		for _, item := range report.Items {
+			m.Items = append(m.Items, &Item{Quantity: item.Quantity, Item: item.Item.String()})
+		}
+		for _, longhouse := range report.Longhouses {
+			m.Longhouses = append(m.Longhouses, &Longhouse{Id: longhouse.Id, Capacity: longhouse.Capacity})
+		}
+		for _, obstacle := range report.Obstacles {
+			o := &Obstacle{Direction: obstacle.Direction.String(), Result: obstacle.Result.String(), Edge: obstacle.Edge.String(), Scouts: obstacle.Scouts}
+			if obstacle.Terrain != 0 {
+				o.Terrain = obstacle.Terrain.String()
+			}
+			m.Obstacles = append(m.Obstacles, o)
+		}
+		for _, patrol := range report.Patrols {
+			p := &Patrol{ScoutNo: patrol.ScoutNo, Lost: patrol.Lost}
+			for _, id := range patrol.Found {
+				p.Found = append(p.Found, string(id))
+			}
+			m.Patrols = append(m.Patrols, p)
+		}
+		for _, resource := range report.Resources {
+			m.Resources = append(m.Resources, resource.String())
+		}
+		for _, settlement := range report.Settlements {
+			m.Settlements = append(m.Settlements, settlement.Name)
+		}
+		if report.Wind != nil {
+			m.Wind = &Wind{Strength: report.Wind.Strength.String(), From: report.Wind.From.String()}
+		}
+		for _, fh := range report.FarHorizons {
+			m.FarHorizons = append(m.FarHorizons, &FarHorizon{Point: fh.Point.String(), Terrain: fh.Terrain.String()})
+		}
+	}
+	return m
+}
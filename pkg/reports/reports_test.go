@@ -0,0 +1,140 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package reports_test
+
+import (
+	"errors"
+	"github.com/mdhender/ottomap/pkg/reports"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	fp, err := os.Open("../../data/input/899-12.0991.report.txt")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer fp.Close()
+
+	rpt, err := reports.Parse(fp, reports.Options{ReportId: "0899-12.0991"})
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if rpt.TurnId != "0899-12" || rpt.Year != 899 || rpt.Month != 12 {
+		t.Errorf("turn: want 0899-12, got %q (%d/%d)", rpt.TurnId, rpt.Year, rpt.Month)
+	}
+	if rpt.Season != "Winter" || rpt.Weather != "FINE" || rpt.NextTurnId != "0900-01" {
+		t.Errorf("turn line: want Winter/FINE/0900-01, got %s/%s/%s", rpt.Season, rpt.Weather, rpt.NextTurnId)
+	}
+	if len(rpt.Units) == 0 || rpt.Units[0].Id != "0991" {
+		t.Fatalf("units: want 0991 first, got %d units", len(rpt.Units))
+	}
+	tribe := rpt.Units[0]
+	if tribe.CurrentHex != "MH 0714" || tribe.PreviousHex != "MH 0714" {
+		t.Errorf("0991: want MH 0714/MH 0714, got %s/%s", tribe.PreviousHex, tribe.CurrentHex)
+	}
+	if tribe.Section == nil {
+		t.Errorf("0991: want section, got nil")
+	} else if tribe.Section.Humans.People != 17670 || tribe.Section.Weight != 531670 || len(tribe.Section.DesiredCommodities) != 0 {
+		t.Errorf("0991: section: want 17670 people, 531670 weight, no commodities, got %d, %d, %v", tribe.Section.Humans.People, tribe.Section.Weight, tribe.Section.DesiredCommodities)
+	}
+	if len(tribe.Scouts) == 0 || tribe.Scouts[0].No != 1 || len(tribe.Scouts[0].Moves) == 0 {
+		t.Fatalf("0991: want moves for scout 1, got %d scouts", len(tribe.Scouts))
+	}
+	if step := tribe.Scouts[0].Moves[0]; step.Direction != "SW" || step.Terrain != "RH" {
+		t.Errorf("scout 1: step 1: want SW RH, got %s %s", step.Direction, step.Terrain)
+	}
+
+	rpt, err = reports.Parse(strings.NewReader("Tribe 0991, , Current Hex = MH 0714\n"), reports.Options{ReportId: "bad"})
+	var rerr *reports.Error
+	if !errors.As(err, &rerr) {
+		t.Fatalf("bad: want *reports.Error, got %v", err)
+	} else if rpt == nil || len(rerr.Diagnostics) != 1 || rerr.Diagnostics[0].Line != 1 {
+		t.Errorf("bad: want report and error on line 1, got %v", rerr)
+	}
+}
+
+func TestParseObservations(t *testing.T) {
+	input := strings.Join([]string{
+		"Tribe 0991, , Current Hex = MH 0714, (Previous Hex = MH 0714)",
+		"Current Turn 899-12 (#0), Winter, FINE\tNext Turn 900-01 (#1), 28/10/2023",
+		"Tribe Movement: Move ",
+		"0991 Status: PRAIRIE, 30 Longhouse L01, 0991",
+		"",
+		"Fleet 0991f1, , Current Hex = MH 0814, (Previous Hex = MH 0714)",
+		"MILD NW Fleet Movement: Move S-O,-(NE O)(Sight Water - N/N, Sight Land - N/NE)",
+		"",
+	}, "\n")
+	rpt, err := reports.Parse(strings.NewReader(input), reports.Options{ReportId: "0899-12.0991"})
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	} else if len(rpt.Units) != 2 || len(rpt.Units[0].Moves) != 1 || len(rpt.Units[1].Moves) != 1 {
+		t.Fatalf("units: want 0991 and 0991f1 with one move each, got %d units", len(rpt.Units))
+	}
+
+	if status := rpt.Units[0].Moves[0]; len(status.Longhouses) != 1 {
+		t.Errorf("0991: longhouses: want 1, got %d", len(status.Longhouses))
+	} else if lh := status.Longhouses[0]; lh.Id != "L01" || lh.Capacity != 30 {
+		t.Errorf("0991: longhouse: want L01/30, got %s/%d", lh.Id, lh.Capacity)
+	}
+
+	step := rpt.Units[1].Moves[0]
+	if step.Wind == nil {
+		t.Errorf("0991f1: wind: want MILD/NW, got nil")
+	} else if step.Wind.Strength != "MILD" || step.Wind.From != "NW" {
+		t.Errorf("0991f1: wind: want MILD/NW, got %s/%s", step.Wind.Strength, step.Wind.From)
+	}
+	if len(step.FarHorizons) != 2 {
+		t.Errorf("0991f1: far horizons: want 2, got %d", len(step.FarHorizons))
+	} else if fh := step.FarHorizons[1]; fh.Point != "NorthNorthEast" || fh.Terrain != "UL" {
+		t.Errorf("0991f1: far horizon: want NorthNorthEast/UL, got %s/%s", fh.Point, fh.Terrain)
+	}
+
+	fp, err := os.Open("../../data/input/900-01.0991.report.txt")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer fp.Close()
+	rpt, err = reports.Parse(fp, reports.Options{ReportId: "0900-01.0991"})
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	var scout *reports.Scout
+	for _, u := range rpt.Units {
+		if u.Id == "1991e1" && len(u.Scouts) > 1 {
+			scout = u.Scouts[1]
+		}
+	}
+	if scout == nil || len(scout.Moves) != 1 {
+		t.Fatalf("1991e1: want one move for scout 2")
+	}
+	step = scout.Moves[0]
+	if len(step.Obstacles) != 1 {
+		t.Errorf("1991e1: scout 2: obstacles: want 1, got %d", len(step.Obstacles))
+	} else if o := step.Obstacles[0]; o.Direction != "SW" || o.Result != "Prohibited" || o.Terrain != "O" || o.Edge != "" || !o.Scouts {
+		t.Errorf("1991e1: scout 2: obstacle: want SW Prohibited O, got %+v", *o)
+	}
+	if len(step.Patrols) != 1 {
+		t.Errorf("1991e1: scout 2: patrols: want 1, got %d", len(step.Patrols))
+	} else if p := step.Patrols[0]; p.ScoutNo != 2 || p.Lost || len(p.Found) != 1 || p.Found[0] != "1991" {
+		t.Errorf("1991e1: scout 2: patrol: want scout 2 found 1991, got %+v", *p)
+	}
+}
+
+// a direction without a terrain used to panic in the parser
+func TestParseDirectionWithoutTerrain(t *testing.T) {
+	input := strings.Join([]string{
+		"Tribe 0991, , Current Hex = MH 0714, (Previous Hex = MH 0714)",
+		"Current Turn 899-12 (#0), Winter, FINE\tNext Turn 900-01 (#1), 28/10/2023",
+		`Tribe Movement: Move SE-GH, \N`,
+		"",
+	}, "\n")
+	rpt, err := reports.Parse(strings.NewReader(input), reports.Options{ReportId: "0899-12.0991"})
+	var rerr *reports.Error
+	if !errors.As(err, &rerr) {
+		t.Fatalf("want *reports.Error, got %v", err)
+	} else if rpt == nil || len(rerr.Diagnostics) != 1 || rerr.Diagnostics[0].Line != 3 {
+		t.Errorf("want report and error on line 3, got %v", rerr)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package reports
+
+import (
+	"github.com/mdhender/ottomap/internal/parser"
+)
+
+// Section is the rest of a unit's section of the report:
+// its population, inventory, skills, transfers, and so on.
+type Section struct {
+	LineNo             int    // line number of the section header
+	Funds              *Funds // only reported for the clan's tribe
+	GoodsTribe         string
+	DesiredCommodities []string    // empty if no commodities are allocated
+	Activities         string      // text of the "Tribe Activities:" line
+	FinalActivities    string      // text of the "Final Activities:" line
+	Transfers          []*Transfer // goods sent to other units
+	Receipts           []*Transfer // goods received from other units
+	Humans             Humans
+	Inventory          []*Inventory
+	Skills             []*Skill
+	Morale             float64
+	Weight             int
+	Notes              []string // lines that aren't otherwise recognized
+}
+
+// Funds is the funds line of the clan's tribe.
+type Funds struct {
+	Received float64
+	Cost     float64
+	Credit   float64
+}
+
+// Humans is the population of the unit.
+type Humans struct {
+	People    int
+	Warriors  int
+	Actives   int
+	Inactives int
+}
+
+// Inventory is an item held by the unit.
+type Inventory struct {
+	Category string // heading the item was listed under, for example "Animals"
+	Name     string
+	Quantity int
+}
+
+// Transfer is a transfer of goods to or from another unit.
+type Transfer struct {
+	UnitId string
+	Goods  []*Item // names are upper case, for example "WARRIORS"
+}
+
+// Skill is a skill and the level the unit has in it.
+type Skill struct {
+	Name  string
+	Level int
+}
+
+// SettlementEntry is a row from the settlements table at the end of the report.
+type SettlementEntry struct {
+	Hex     string
+	Name    string
+	Note    string
+	Type    string
+	Subtype string
+}
+
+// TransferEntry is a row from the transfers table at the end of the report.
+// Actual is zero when the report leaves it blank.
+type TransferEntry struct {
+	From      string
+	To        string
+	Item      string
+	Requested int
+	Actual    int
+	Message   string
+}
+
+func newSection(section *parser.Section_t) *Section {
+	s := &Section{
+		LineNo:             section.LineNo,
+		GoodsTribe:         section.GoodsTribe,
+		DesiredCommodities: section.DesiredCommodities,
+		Activities:         section.Activities,
+		FinalActivities:    section.FinalActivities,
+		Humans: Humans{
+			People:    section.Humans.People,
+			Warriors:  section.Humans.Warriors,
+			Actives:   section.Humans.Actives,
+			Inactives: section.Humans.Inactives,
+		},
+		Morale: section.Morale,
+		Weight: section.Weight,
+		Notes:  section.Notes,
+	}
+	if section.Funds != nil {
+		s.Funds = &Funds{Received: section.Funds.Received, Cost: section.Funds.Cost, Credit: section.Funds.Credit}
+	}
+	s.Transfers = newTransfers(section.Transfers)
+	s.Receipts = newTransfers(section.Receipts)
+	for _, item := range section.Inventory {
+		s.Inventory = append(s.Inventory, &Inventory{Category: item.Category, Name: item.Name, Quantity: item.Quantity})
+	}
+	for _, skill := range section.Skills {
+		s.Skills = append(s.Skills, &Skill{Name: skill.Name, Level: skill.Level})
+	}
+	return s
+}
+
+func newTransfers(transfers []*parser.Transfer_t) []*Transfer {
+	var list []*Transfer
+	for _, transfer := range transfers {
+		t := &Transfer{UnitId: string(transfer.UnitId)}
+		for _, goods := range transfer.Goods {
+			t.Goods = append(t.Goods, &Item{Quantity: goods.Quantity, Item: goods.Name})
+		}
+		list = append(list, t)
+	}
+	return list
+}
+
+func newSettlementEntry(entry *parser.SettlementEntry_t) *SettlementEntry {
+	return &SettlementEntry{Hex: entry.Hex, Name: entry.Name, Note: entry.Note, Type: entry.Type, Subtype: entry.Subtype}
+}
+
+func newTransferEntry(entry *parser.TransferEntry_t) *TransferEntry {
+	return &TransferEntry{
+		From:      string(entry.From),
+		To:        string(entry.To),
+		Item:      entry.Item,
+		Requested: entry.Requested,
+		Actual:    entry.Actual,
+		Message:   entry.Message,
+	}
+}
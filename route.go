@@ -0,0 +1,135 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/mdhender/ottomap/internal/atlas"
+	"github.com/mdhender/ottomap/internal/coords"
+	"github.com/mdhender/ottomap/internal/route"
+	"github.com/mdhender/ottomap/internal/tiles"
+	"github.com/mdhender/ottomap/pipeline"
+	"github.com/spf13/cobra"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var argsRoute struct {
+	clanId string
+	paths  struct {
+		data   string
+		input  string
+		output string
+	}
+	originGrid string
+	from       string // hex to start from, for example "AB 0101"
+	to         string // hex to end at
+}
+
+var cmdRoute = &cobra.Command{
+	Use:   "route",
+	Short: "Find the cheapest known path between two hexes",
+	Long: `Find the cheapest path through explored terrain and print the move order for it.
+
+The path is found on the map saved in the clan's atlas (data/output/CLAN.atlas.json).
+If there is no atlas, the turn reports in data/input are walked to create the map.`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if len(argsRoute.clanId) != 4 || argsRoute.clanId[0] != '0' {
+			return fmt.Errorf("clan-id must be a 4 digit number starting with 0")
+		} else if n, err := strconv.Atoi(argsRoute.clanId[1:]); err != nil || n < 0 || n > 9999 {
+			return fmt.Errorf("clan-id must be a 4 digit number starting with 0")
+		}
+
+		if argsRoute.paths.data == "" {
+			return fmt.Errorf("path to data folder is required")
+		} else if strings.TrimSpace(argsRoute.paths.data) != argsRoute.paths.data {
+			log.Fatalf("error: data: leading or trailing spaces are not allowed\n")
+		} else if path, err := abspath(argsRoute.paths.data); err != nil {
+			log.Fatalf("error: data: %v\n", err)
+		} else {
+			argsRoute.paths.data = path
+		}
+
+		argsRoute.paths.input = filepath.Join(argsRoute.paths.data, "input")
+		if path, err := abspath(argsRoute.paths.input); err != nil {
+			log.Fatalf("error: data: %v\n", err)
+		} else {
+			argsRoute.paths.input = path
+		}
+
+		argsRoute.paths.output = filepath.Join(argsRoute.paths.data, "output")
+		if path, err := abspath(argsRoute.paths.output); err != nil {
+			log.Fatalf("error: data: %v\n", err)
+		} else {
+			argsRoute.paths.output = path
+		}
+
+		if argsRoute.originGrid != "" {
+			if len(argsRoute.originGrid) != 2 || strings.Trim(argsRoute.originGrid, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+				log.Fatalf("error: originGrid %q: must be two upper-case letters\n", argsRoute.originGrid)
+			}
+		}
+
+		return nil
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		from, err := coords.HexToMap(argsRoute.from)
+		if err != nil {
+			log.Fatalf("error: from: %q: %v\n", argsRoute.from, err)
+		}
+		to, err := coords.HexToMap(argsRoute.to)
+		if err != nil {
+			log.Fatalf("error: to: %q: %v\n", argsRoute.to, err)
+		}
+
+		// use the world map that the render command saved in the atlas.
+		// if there isn't one, walk the turn reports like the unit command.
+		var worldMap *tiles.Map_t
+		atlasPath := filepath.Join(argsRoute.paths.output, fmt.Sprintf("%s.atlas.json", argsRoute.clanId))
+		if a, err := atlas.Load(atlasPath); errors.Is(err, os.ErrNotExist) {
+			log.Printf("route: %s: not found: walking the turn reports\n", atlasPath)
+			opts := pipeline.Options{
+				ClanId:            argsRoute.clanId,
+				InputPath:         argsRoute.paths.input,
+				OriginGrid:        argsRoute.originGrid,
+				QuitOnInvalidGrid: argsRoute.originGrid == "",
+				WarnOnInvalidGrid: true,
+			}
+			result, err := pipeline.Walk(opts)
+			if errors.Is(err, pipeline.ErrDiagnostics) {
+				exitWithDiagnostics(result.Diagnostics)
+			} else if err != nil {
+				log.Fatalf("error: %v\n", err)
+			}
+			worldMap = result.WorldMap
+			log.Printf("route: %s: walked %d tiles through turn %s\n", argsRoute.paths.input, worldMap.Length(), result.TurnId)
+		} else if err != nil {
+			log.Fatalf("error: atlas: %s: %v\n", atlasPath, err)
+		} else if a.ClanId != argsRoute.clanId {
+			log.Fatalf("error: atlas: %s: clan %q: expected clan %q\n", atlasPath, a.ClanId, argsRoute.clanId)
+		} else if worldMap, _, err = a.ToMap(); err != nil {
+			log.Fatalf("error: atlas: %s: %v\n", atlasPath, err)
+		} else {
+			log.Printf("route: %s: loaded %d tiles through turn %s\n", atlasPath, worldMap.Length(), a.TurnId)
+		}
+
+		r, err := route.Find(worldMap, from, to)
+		if err != nil {
+			log.Fatalf("error: route: %v\n", err)
+		}
+
+		fmt.Printf("route: %s to %s: %d steps: %d movement points\n", from.GridString(), to.GridString(), len(r.Steps), r.Cost)
+		for n, step := range r.Steps {
+			var border []string
+			for _, e := range step.Edges {
+				border = append(border, e.String())
+			}
+			fmt.Printf("%3d: %-2s %s  %-22s %3d %4d  %s\n", n+1, step.Direction, step.To.GridString(), step.Terrain, step.Cost, step.Total, strings.Join(border, ","))
+		}
+		fmt.Printf("%s\n", r.Order())
+	},
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2024 Michael D Henderson. All rights reserved.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/mdhender/ottomap/internal/parser"
+	"github.com/mdhender/ottomap/internal/turns"
+	"github.com/mdhender/ottomap/pipeline"
+	"github.com/spf13/cobra"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var argsUnit struct {
+	paths struct {
+		data  string
+		input string
+	}
+	originGrid string
+	json       bool // print the history as JSON
+}
+
+var cmdUnit = &cobra.Command{
+	Use:   "unit <id>",
+	Short: "Print the movement history of a unit",
+	Long:  `Walk the turn reports and print the hexes a unit moved through in every turn.`,
+	Args:  cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if id := args[0]; len(id) < 4 || id[0] != '0' || strings.Trim(id[:4], "0123456789") != "" {
+			return fmt.Errorf("unit id must start with the 4 digit clan number")
+		}
+
+		if argsUnit.paths.data == "" {
+			return fmt.Errorf("path to data folder is required")
+		} else if strings.TrimSpace(argsUnit.paths.data) != argsUnit.paths.data {
+			log.Fatalf("error: data: leading or trailing spaces are not allowed\n")
+		} else if path, err := abspath(argsUnit.paths.data); err != nil {
+			log.Fatalf("error: data: %v\n", err)
+		} else {
+			argsUnit.paths.data = path
+		}
+
+		argsUnit.paths.input = filepath.Join(argsUnit.paths.data, "input")
+		if path, err := abspath(argsUnit.paths.input); err != nil {
+			log.Fatalf("error: data: %v\n", err)
+		} else {
+			argsUnit.paths.input = path
+		}
+
+		if argsUnit.originGrid != "" {
+			if len(argsUnit.originGrid) != 2 || strings.Trim(argsUnit.originGrid, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+				log.Fatalf("error: originGrid %q: must be two upper-case letters\n", argsUnit.originGrid)
+			}
+		}
+
+		return nil
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		unitId := parser.UnitId_t(args[0])
+
+		// the unit command only walks the reports; it doesn't create a map
+		opts := pipeline.Options{
+			ClanId:            args[0][:4],
+			InputPath:         argsUnit.paths.input,
+			OriginGrid:        argsUnit.originGrid,
+			QuitOnInvalidGrid: argsUnit.originGrid == "",
+			WarnOnInvalidGrid: true,
+		}
+		result, err := pipeline.Walk(opts)
+		if errors.Is(err, pipeline.ErrDiagnostics) {
+			exitWithDiagnostics(result.Diagnostics)
+		} else if err != nil {
+			log.Fatalf("error: %v\n", err)
+		}
+
+		history := turns.UnitHistory(result.Turns, unitId)
+		if len(history) == 0 {
+			log.Fatalf("error: unit %q: not found in the turn reports\n", unitId)
+		}
+
+		if argsUnit.json {
+			data, err := json.MarshalIndent(history, "", "  ")
+			if err != nil {
+				log.Fatalf("error: json: %v\n", err)
+			}
+			fmt.Printf("%s\n", data)
+			return
+		}
+
+		for _, ut := range history {
+			fmt.Printf("%s  %-8s  %s -> %s  (%s)\n", ut.TurnId, unitId, ut.Start, ut.End, ut.ReportId)
+			if ut.Follows != "" {
+				fmt.Printf("    follows %s\n", ut.Follows)
+			} else if ut.GoesTo != "" {
+				fmt.Printf("    goes to %s\n", ut.GoesTo)
+			}
+			for _, step := range ut.Steps {
+				printUnitStep(os.Stdout, "    ", step)
+			}
+			for _, scout := range ut.Scouts {
+				fmt.Printf("    scout %d\n", scout.No)
+				for _, step := range scout.Steps {
+					printUnitStep(os.Stdout, "      ", step)
+				}
+			}
+		}
+	},
+}
+
+// printUnitStep prints one step of a unit's move, for example
+//
+//	line   24  step  1  NE    succeeded  MH 0813  PRAIRIE
+func printUnitStep(w *os.File, indent string, step *turns.UnitStep_t) {
+	var move string
+	switch {
+	case step.Follows != "":
+		move = "follows " + step.Follows
+	case step.GoesTo != "":
+		move = "goes to " + step.GoesTo
+	case step.Still:
+		move = "still"
+	default:
+		move = step.Direction.String()
+	}
+	_, _ = fmt.Fprintf(w, "%sline %4d  step %2d  %-5s %-11s %-8s %s\n", indent, step.LineNo, step.StepNo, move, step.Result, step.Hex, step.Terrain)
+}